@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -21,10 +22,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/telemetry"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+const (
+	// Placeholder credentials used to sign replayed API calls.
+	vcrReplayAccessKey = "vcr-replay-access-key"
+	vcrReplaySecretKey = "vcr-replay-secret-key"
+)
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
@@ -61,6 +70,7 @@ type Config struct {
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	VCR                            *vcr.Config
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -69,6 +79,27 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	// Record or replay AWS API calls unless the caller has already set up an HTTP client, as acceptance tests do.
+	if c.VCR != nil && client.HTTPClient(ctx) == nil {
+		tflog.Info(ctx, "Configuring provider-wide VCR recorder", map[string]any{
+			"tf_aws.vcr.cassette": c.VCR.CassettePath(),
+		})
+		httpClient, err := vcr.ProviderHTTPClient(ctx, c.VCR)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring VCR recorder: %s", err)
+		}
+		client.SetHTTPClient(ctx, httpClient)
+
+		if c.VCR.IsReplaying() {
+			// Replayed API calls need no real credentials.
+			if c.AccessKey == "" {
+				c.AccessKey, c.SecretKey, c.Token = vcrReplayAccessKey, vcrReplaySecretKey, ""
+			}
+			c.Profile = ""
+			c.EC2MetadataServiceEnableState = imds.ClientDisabled
+		}
+	}
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -155,6 +186,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.VCR != nil {
+		// Don't retry API calls that have no recorded interaction.
+		retryer := cfg.Retryer
+		cfg.Retryer = func() aws.Retryer {
+			return AddIsErrorRetryables(retryer().(aws.RetryerV2), retry.IsErrorRetryableFunc(vcr.InteractionNotFoundRetryableFunc))
+		}
+	}
+
+	if telemetry.IsEnabled() {
+		cfg.APIOptions = append(cfg.APIOptions, telemetry.APIOptions(TelemetryKey)...)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
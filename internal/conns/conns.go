@@ -27,6 +27,20 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithActions is an interface that extends ServicePackage with actions.
+// Actions are operations, such as invoking a Lambda function, that are run outside of the resource lifecycle.
+type ServicePackageWithActions interface {
+	ServicePackage
+	Actions(context.Context) []*types.ServicePackageAction
+}
+
+// ServicePackageWithSDKListResources is an interface that extends ServicePackage with list resources for Plugin SDK resources.
+// List resources are used by `terraform query` to discover existing resources.
+type ServicePackageWithSDKListResources interface {
+	ServicePackage
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -78,3 +92,13 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
 }
+
+// TelemetryKey returns the key that identifies the request in progress to the telemetry recorder.
+// Each request has its own resource context value, so that value is used as the key.
+func TelemetryKey(ctx context.Context) any {
+	if v, ok := FromContext(ctx); ok {
+		return v
+	}
+
+	return nil
+}
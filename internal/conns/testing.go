@@ -4,6 +4,7 @@
 package conns
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -16,3 +17,15 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetAccountID is only intended for use in tests
+func SetAccountID(client *AWSClient, accountID string) {
+	client.accountID = accountID
+}
+
+// SetRegion is only intended for use in tests
+func SetRegion(client *AWSClient, region string) {
+	client.awsConfig = &aws.Config{
+		Region: region,
+	}
+}
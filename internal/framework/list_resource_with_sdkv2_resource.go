@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithSDKv2Resourcer is implemented by list resources that list instances of a Plugin SDK resource.
+type ListResourceWithSDKv2Resourcer interface {
+	SetSDKv2Resource(r *schema.Resource, identitySpec inttypes.Identity)
+}
+
+var (
+	_ ListResourceWithSDKv2Resourcer = (*ListResourceWithSDKv2Resource)(nil)
+)
+
+// ListResourceWithSDKv2Resource is a structure to be embedded within a list resource that lists instances of a Plugin SDK resource.
+// The provider sets the (wrapped) SDK resource and its identity specification before the list resource is used.
+type ListResourceWithSDKv2Resource struct {
+	withMeta
+	resource     *schema.Resource
+	identitySpec inttypes.Identity
+}
+
+// Metadata should return the full name of the list resource, such as
+// examplecloud_thing.
+func (*ListResourceWithSDKv2Resource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	// This method is implemented in the wrappers.
+	panic("not implemented") // lintignore:R009
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined ListResource type.
+func (l *ListResourceWithSDKv2Resource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
+
+// SetSDKv2Resource sets the Plugin SDK resource whose instances are listed.
+func (l *ListResourceWithSDKv2Resource) SetSDKv2Resource(r *schema.Resource, identitySpec inttypes.Identity) {
+	l.resource = r
+	l.identitySpec = identitySpec
+}
+
+// RawV5Schemas returns the Plugin SDK resource's schema and identity schema, used to encode list results.
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = l.resource.ProtoSchema(ctx)()
+	if f := l.resource.ProtoIdentitySchema(ctx); f != nil {
+		response.ProtoV5IdentitySchema = f()
+	}
+}
+
+// ResourceData returns a new, empty ResourceData for a listed resource instance.
+func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
+	return l.resource.Data(&terraform.InstanceState{})
+}
+
+// NewListResult returns a list result for a listed resource instance.
+// The ResourceData must have its ID and identifying attributes set.
+// If the full resource is requested, the SDK resource's Read handler is run first, exactly as on import.
+// NewListResult returns false if the resource instance no longer exists and must be omitted from the results.
+func (l *ListResourceWithSDKv2Resource) NewListResult(ctx context.Context, request list.ListRequest, d *schema.ResourceData, displayName string) (list.ListResult, bool) {
+	result := request.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	ok, err := l.setResult(ctx, request.IncludeResource, d, &result)
+
+	if err != nil {
+		result.Diagnostics.AddError(fmt.Sprintf("reading listed resource (%s)", d.Id()), err.Error())
+
+		return result, true
+	}
+
+	return result, ok
+}
+
+// setResult populates a list result from a listed resource instance's ResourceData.
+func (l *ListResourceWithSDKv2Resource) setResult(ctx context.Context, includeResource bool, d *schema.ResourceData, result *list.ListResult) (bool, error) {
+	c := l.Meta()
+
+	if _, ok := l.resource.SchemaMap()[names.AttrRegion]; ok {
+		if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+			return false, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	if includeResource {
+		if diags := l.resource.ReadWithoutTimeout(ctx, d, c); diags.HasError() {
+			return false, sdkdiag.DiagnosticsError(diags)
+		}
+
+		if d.Id() == "" {
+			return false, nil
+		}
+	}
+
+	if err := l.setIdentity(ctx, d); err != nil {
+		return false, err
+	}
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		return false, fmt.Errorf("converting identity: %w", err)
+	}
+	result.Identity.Raw = *identity
+
+	if includeResource {
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			return false, fmt.Errorf("converting state: %w", err)
+		}
+		result.Resource.Raw = *state
+	}
+
+	return true, nil
+}
+
+// ListResultError returns a list result that reports a fatal error listing resource instances.
+func ListResultError(summary string, err error) list.ListResult {
+	return list.ListResult{
+		Diagnostics: diag.Diagnostics{
+			diag.NewErrorDiagnostic(summary, err.Error()),
+		},
+	}
+}
+
+// setIdentity sets the resource instance's identity attributes.
+// This matches the SDK provider's identity interceptor.
+func (l *ListResourceWithSDKv2Resource) setIdentity(ctx context.Context, d *schema.ResourceData) error {
+	c := l.Meta()
+
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	for _, attr := range l.identitySpec.Attributes {
+		var v string
+
+		switch attr.Name {
+		case names.AttrAccountID:
+			v = c.AccountID(ctx)
+
+		case names.AttrRegion:
+			v = c.Region(ctx)
+
+		case names.AttrID:
+			v = d.Id()
+
+		default:
+			v = d.Get(attr.Name).(string)
+		}
+
+		if err := identity.Set(attr.Name, v); err != nil {
+			return fmt.Errorf("setting identity %s: %w", attr.Name, err)
+		}
+	}
+
+	return nil
+}
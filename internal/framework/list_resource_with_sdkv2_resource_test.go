@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func listTestResource(read func(*schema.ResourceData)) *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: func(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+			read(d)
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"account_id": {
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+					},
+					"name": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+					},
+					"region": {
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+					},
+				}
+			},
+		},
+	}
+}
+
+func listTestListResource(t *testing.T, r *schema.Resource) *ListResourceWithSDKv2Resource {
+	t.Helper()
+
+	client := &conns.AWSClient{}
+	conns.SetAccountID(client, "123456789012")
+	conns.SetRegion(client, "a-region-1")
+
+	var l ListResourceWithSDKv2Resource
+	l.SetSDKv2Resource(r, inttypes.RegionalSingleParameterIdentity("name"))
+	l.Configure(t.Context(), resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+
+	return &l
+}
+
+func listTestValues(t *testing.T, v tftypes.Value) map[string]string {
+	t.Helper()
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		t.Fatalf("converting value: %s", err)
+	}
+
+	values := make(map[string]string)
+	for k, v := range attrs {
+		var s *string
+		if err := v.As(&s); err != nil {
+			t.Fatalf("converting attribute %s: %s", k, err)
+		}
+		if s != nil {
+			values[k] = *s
+		}
+	}
+
+	return values
+}
+
+func TestListResourceWithSDKv2Resource_setResult(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		includeResource bool
+		read            func(*schema.ResourceData)
+		expectedOK      bool
+		expectedState   map[string]string
+	}{
+		"identity only": {
+			read: func(*schema.ResourceData) {
+				t.Error("unexpected Read")
+			},
+			expectedOK: true,
+		},
+		"include resource": {
+			includeResource: true,
+			read: func(d *schema.ResourceData) {
+				d.Set("description", "test description")
+			},
+			expectedOK: true,
+			expectedState: map[string]string{
+				"description": "test description",
+				"id":          "test-name",
+				"name":        "test-name",
+				"region":      "a-region-1",
+			},
+		},
+		"include resource, not found": {
+			includeResource: true,
+			read: func(d *schema.ResourceData) {
+				d.SetId("")
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			l := listTestListResource(t, listTestResource(testCase.read))
+
+			d := l.ResourceData()
+			d.SetId("test-name")
+			d.Set("name", "test-name")
+
+			result := list.ListResult{
+				Identity: &tfsdk.ResourceIdentity{},
+				Resource: &tfsdk.Resource{},
+			}
+			ok, err := l.setResult(ctx, testCase.includeResource, d, &result)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Fatalf("ok: got %t, want %t", got, want)
+			}
+			if !ok {
+				return
+			}
+
+			expectedIdentity := map[string]string{
+				"account_id": "123456789012",
+				"name":       "test-name",
+				"region":     "a-region-1",
+			}
+			if diff := cmp.Diff(listTestValues(t, result.Identity.Raw), expectedIdentity); diff != "" {
+				t.Errorf("unexpected identity diff (+wanted, -got): %s", diff)
+			}
+
+			if testCase.includeResource {
+				if diff := cmp.Diff(listTestValues(t, result.Resource.Raw), testCase.expectedState); diff != "" {
+					t.Errorf("unexpected state diff (+wanted, -got): %s", diff)
+				}
+			} else if !result.Resource.Raw.IsNull() {
+				t.Errorf("unexpected resource: %s", result.Resource.Raw)
+			}
+		})
+	}
+}
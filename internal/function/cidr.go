@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// VPC and subnet sizing reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/vpc-cidr-blocks.html
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// ipv4MinPrefixLength is the largest IPv4 VPC or subnet CIDR block (/16)
+	ipv4MinPrefixLength = 16
+	// ipv4MaxPrefixLength is the smallest IPv4 VPC or subnet CIDR block (/28)
+	ipv4MaxPrefixLength = 28
+	// ipv6MinVPCPrefixLength is the largest IPv6 VPC CIDR block (/44)
+	ipv6MinVPCPrefixLength = 44
+	// ipv6MaxVPCPrefixLength is the smallest IPv6 VPC CIDR block (/60)
+	ipv6MaxVPCPrefixLength = 60
+	// ipv6MinSubnetPrefixLength is the largest IPv6 subnet CIDR block (/44)
+	ipv6MinSubnetPrefixLength = 44
+	// ipv6MaxSubnetPrefixLength is the smallest IPv6 subnet CIDR block (/64)
+	ipv6MaxSubnetPrefixLength = 64
+	// ipv6SubnetPrefixLengthIncrement is the increment in which IPv6 subnet prefix lengths can be specified
+	ipv6SubnetPrefixLengthIncrement = 4
+
+	// reservedAddressesPerSubnet is the number of addresses AWS reserves in each subnet:
+	// the first four addresses and the last address in the CIDR block
+	reservedAddressesPerSubnet = 5
+)
+
+// parseCIDRBlock parses a CIDR block, which must be the canonical CIDR block for its network.
+func parseCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := inttypes.ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return prefix.Masked(), nil
+}
+
+// validateSubnetPrefixLength validates that a CIDR block is an allowed size for a subnet.
+func validateSubnetPrefixLength(prefix netip.Prefix) error {
+	bits := prefix.Bits()
+
+	if prefix.Addr().Is4() {
+		if bits < ipv4MinPrefixLength || bits > ipv4MaxPrefixLength {
+			return fmt.Errorf("IPv4 subnet CIDR block %q must have a prefix length between /%d and /%d", prefix, ipv4MinPrefixLength, ipv4MaxPrefixLength)
+		}
+
+		return nil
+	}
+
+	if bits < ipv6MinSubnetPrefixLength || bits > ipv6MaxSubnetPrefixLength || bits%ipv6SubnetPrefixLengthIncrement != 0 {
+		return fmt.Errorf("IPv6 subnet CIDR block %q must have a prefix length between /%d and /%d in increments of /%d", prefix, ipv6MinSubnetPrefixLength, ipv6MaxSubnetPrefixLength, ipv6SubnetPrefixLengthIncrement)
+	}
+
+	return nil
+}
+
+// prefixSize returns the number of addresses in a CIDR block.
+func prefixSize(prefix netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+}
+
+// subnetPrefix returns the index'th CIDR block of the specified prefix length within a parent CIDR block.
+func subnetPrefix(parent netip.Prefix, bits int, index int64) (netip.Prefix, error) {
+	if bits < parent.Bits() || bits > parent.Addr().BitLen() {
+		return netip.Prefix{}, fmt.Errorf("prefix length /%d is not valid within %q", bits, parent)
+	}
+
+	offset := new(big.Int).Lsh(big.NewInt(index), uint(parent.Addr().BitLen()-bits))
+	if offset.Cmp(prefixSize(parent)) >= 0 {
+		return netip.Prefix{}, fmt.Errorf("%q has no room for subnet %d of size /%d", parent, index, bits)
+	}
+
+	b := parent.Addr().AsSlice()
+	addr := new(big.Int).Add(new(big.Int).SetBytes(b), offset).FillBytes(make([]byte, len(b)))
+	ip, _ := netip.AddrFromSlice(addr)
+
+	return netip.PrefixFrom(ip, bits), nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxAZCount is the maximum number of Availability Zones a CIDR block can be split for.
+// It comfortably exceeds the number of Availability Zones in any Region.
+const maxAZCount = 32
+
+var _ function.Function = cidrSplitForAZsFunction{}
+
+func NewCIDRSplitForAZsFunction() function.Function {
+	return &cidrSplitForAZsFunction{}
+}
+
+type cidrSplitForAZsFunction struct{}
+
+func (f cidrSplitForAZsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_split_for_azs"
+}
+
+func (f cidrSplitForAZsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_split_for_azs Function",
+		MarkdownDescription: "Splits a VPC CIDR block into one subnet CIDR block per Availability Zone. " +
+			"IPv4 CIDR blocks are split into the largest equally sized subnets that fit, which must be no smaller than /28. " +
+			"IPv6 CIDR blocks are split into /64 subnets.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 VPC CIDR block",
+			},
+			function.Int64Parameter{
+				Name:                "az_count",
+				MarkdownDescription: "Number of Availability Zones",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSplitForAZsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var azCount int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &azCount))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseCIDRBlock(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if azCount < 1 || azCount > maxAZCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("az_count must be at least 1 and at most %d", maxAZCount)))
+		return
+	}
+
+	result, err := cidrSplitForAZs(prefix, azCount)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrSplitForAZs returns the first n subnet CIDR blocks carved from a VPC CIDR block.
+func cidrSplitForAZs(vpc netip.Prefix, n int64) ([]string, error) {
+	// Number of additional prefix bits required to address n subnets.
+	newBits := bits.Len64(uint64(n - 1))
+	subnetBits := vpc.Bits() + newBits
+
+	if vpc.Addr().Is4() {
+		if v := vpc.Bits(); v < ipv4MinPrefixLength || v > ipv4MaxPrefixLength {
+			return nil, fmt.Errorf("IPv4 VPC CIDR block %q must have a prefix length between /%d and /%d", vpc, ipv4MinPrefixLength, ipv4MaxPrefixLength)
+		}
+
+		if subnetBits > ipv4MaxPrefixLength {
+			return nil, fmt.Errorf("IPv4 VPC CIDR block %q is too small to split into %d subnets of at least /%d", vpc, n, ipv4MaxPrefixLength)
+		}
+	} else {
+		if v := vpc.Bits(); v < ipv6MinVPCPrefixLength || v > ipv6MaxVPCPrefixLength || v%ipv6SubnetPrefixLengthIncrement != 0 {
+			return nil, fmt.Errorf("IPv6 VPC CIDR block %q must have a prefix length between /%d and /%d in increments of /%d", vpc, ipv6MinVPCPrefixLength, ipv6MaxVPCPrefixLength, ipv6SubnetPrefixLengthIncrement)
+		}
+
+		if subnetBits > ipv6MaxSubnetPrefixLength {
+			return nil, fmt.Errorf("IPv6 VPC CIDR block %q is too small to split into %d subnets of size /%d", vpc, n, ipv6MaxSubnetPrefixLength)
+		}
+
+		subnetBits = ipv6MaxSubnetPrefixLength
+	}
+
+	result := make([]string, 0, n)
+	for i := range n {
+		subnet, err := subnetPrefix(vpc, subnetBits, i)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, subnet.String())
+	}
+
+	return result, nil
+}
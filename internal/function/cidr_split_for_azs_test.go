@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSplitForAZsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSplitForAZsFunctionConfig("10.0.0.0/16", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.0.0/18,10.0.64.0/18,10.0.128.0/18"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitForAZsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSplitForAZsFunctionConfig("2001:db8:0:100::/56", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2001:db8:0:100::/64,2001:db8:0:101::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitForAZsFunction_tooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSplitForAZsFunctionConfig("10.0.0.0/26", 5),
+				ExpectError: regexache.MustCompile(`too[\s\n]*small`),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitForAZsFunction_invalidAZCount(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSplitForAZsFunctionConfig("10.0.0.0/16", 0),
+				ExpectError: regexache.MustCompile(`az_count[\s\n]*must[\s\n]*be[\s\n]*at[\s\n]*least[\s\n]*1`),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitForAZsFunction_tooManyAZs(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSplitForAZsFunctionConfig("2001:db8::/44", 1<<20),
+				ExpectError: regexache.MustCompile(`az_count[\s\n]*must[\s\n]*be[\s\n]*at[\s\n]*least[\s\n]*1[\s\n]*and[\s\n]*at[\s\n]*most[\s\n]*32`),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitForAZsFunction_invalidIPv6PrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSplitForAZsFunctionConfig("::/0", 3),
+				ExpectError: regexache.MustCompile(`must[\s\n]*have[\s\n]*a[\s\n]*prefix[\s\n]*length[\s\n]*between[\s\n]*/44[\s\n]*and[\s\n]*/60`),
+			},
+		},
+	})
+}
+
+func testCIDRSplitForAZsFunctionConfig(cidr string, azCount int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::cidr_split_for_azs(%[1]q, %[2]d))
+}
+`, cidr, azCount)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ec2InstanceFamilyInfoResultAttrTypes = map[string]attr.Type{
+	"family":                       types.StringType,
+	"series":                       types.StringType,
+	"generation":                   types.Int64Type,
+	"attributes":                   types.StringType,
+	"size":                         types.StringType,
+	"vcpus":                        types.Int64Type,
+	"memory_mib":                   types.Int64Type,
+	"maximum_network_interfaces":   types.Int64Type,
+	"ipv4_addresses_per_interface": types.Int64Type,
+	"hypervisor":                   types.StringType,
+	"architecture":                 types.StringType,
+}
+
+var _ function.Function = ec2InstanceFamilyInfoFunction{}
+
+func NewEC2InstanceFamilyInfoFunction() function.Function {
+	return &ec2InstanceFamilyInfoFunction{}
+}
+
+type ec2InstanceFamilyInfoFunction struct{}
+
+func (f ec2InstanceFamilyInfoFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ec2_instance_family_info"
+}
+
+func (f ec2InstanceFamilyInfoFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ec2_instance_family_info Function",
+		MarkdownDescription: "Returns the family, generation and size of an EC2 instance type, together with its " +
+			"vCPU, memory and network interface limits. Details that are not recorded for the instance type are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "instance_type",
+				MarkdownDescription: "EC2 instance type, e.g. `m5.large`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: ec2InstanceFamilyInfoResultAttrTypes,
+		},
+	}
+}
+
+func (f ec2InstanceFamilyInfoFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var instanceType string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &instanceType))
+	if resp.Error != nil {
+		return
+	}
+
+	v, err := findEC2InstanceType(instanceType)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	name, err := parseEC2InstanceTypeName(instanceType)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"family":                       types.StringValue(name.family),
+		"series":                       types.StringValue(name.series),
+		"generation":                   types.Int64Value(int64(name.generation)),
+		"attributes":                   types.StringValue(name.attributes),
+		"size":                         types.StringValue(name.size),
+		"vcpus":                        ec2InstanceTypeInt64Value(v.vCPUs),
+		"memory_mib":                   ec2InstanceTypeInt64Value(v.memoryMiB),
+		"maximum_network_interfaces":   types.Int64Value(int64(v.maximumNetworkInterfaces)),
+		"ipv4_addresses_per_interface": types.Int64Value(int64(v.ipv4AddressesPerInterface)),
+		"hypervisor":                   ec2InstanceTypeStringValue(v.hypervisor),
+		"architecture":                 ec2InstanceTypeStringValue(v.architecture),
+	}
+
+	result, d := types.ObjectValue(ec2InstanceFamilyInfoResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ec2InstanceTypeInt64Value returns a null value for instance type details that are not recorded.
+func ec2InstanceTypeInt64Value(v int) types.Int64 {
+	if v == 0 {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(v))
+}
+
+// ec2InstanceTypeStringValue returns a null value for instance type details that are not recorded.
+func ec2InstanceTypeStringValue(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(v)
+}
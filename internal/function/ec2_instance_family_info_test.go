@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEC2InstanceFamilyInfoFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2InstanceFamilyInfoFunctionConfig("g4dn.xlarge"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("family", "g4dn"),
+					resource.TestCheckOutput("series", "g"),
+					resource.TestCheckOutput("generation", "4"),
+					resource.TestCheckOutput("attributes", "dn"),
+					resource.TestCheckOutput("size", "xlarge"),
+					resource.TestCheckOutput("vcpus", "4"),
+					resource.TestCheckOutput("memory_mib", "16384"),
+					resource.TestCheckOutput("maximum_network_interfaces", "3"),
+					resource.TestCheckOutput("ipv4_addresses_per_interface", "10"),
+					resource.TestCheckOutput("hypervisor", "nitro"),
+					resource.TestCheckOutput("architecture", "x86_64"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2InstanceFamilyInfoFunction_arm64(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2InstanceFamilyInfoFunctionConfig("m7g.medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("family", "m7g"),
+					resource.TestCheckOutput("series", "m"),
+					resource.TestCheckOutput("generation", "7"),
+					resource.TestCheckOutput("attributes", "g"),
+					resource.TestCheckOutput("size", "medium"),
+					resource.TestCheckOutput("architecture", "arm64"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2InstanceFamilyInfoFunction_unrecordedDetails(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2InstanceFamilyInfoFunctionConfig_unrecordedDetails("m7i.large"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("family", "m7i"),
+					resource.TestCheckOutput("size", "large"),
+					resource.TestCheckOutput("maximum_network_interfaces", "3"),
+					resource.TestCheckOutput("ipv4_addresses_per_interface", "10"),
+					resource.TestCheckOutput("hypervisor", "nitro"),
+					resource.TestCheckOutput("vcpus_null", acctest.CtTrue),
+					resource.TestCheckOutput("memory_mib_null", acctest.CtTrue),
+					resource.TestCheckOutput("architecture_null", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testEC2InstanceFamilyInfoFunctionConfig_unrecordedDetails("p5.48xlarge"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("family", "p5"),
+					resource.TestCheckOutput("size", "48xlarge"),
+					resource.TestCheckOutput("maximum_network_interfaces", "64"),
+					resource.TestCheckOutput("ipv4_addresses_per_interface", "50"),
+					resource.TestCheckOutput("hypervisor", "nitro"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2InstanceFamilyInfoFunction_unknownInstanceType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEC2InstanceFamilyInfoFunctionConfig("m5.invalid"),
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*known`),
+			},
+		},
+	})
+}
+
+func testEC2InstanceFamilyInfoFunctionConfig(instanceType string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::ec2_instance_family_info(%[1]q)
+}
+
+output "family" {
+  value = local.test.family
+}
+
+output "series" {
+  value = local.test.series
+}
+
+output "generation" {
+  value = local.test.generation
+}
+
+output "attributes" {
+  value = local.test.attributes
+}
+
+output "size" {
+  value = local.test.size
+}
+
+output "vcpus" {
+  value = local.test.vcpus
+}
+
+output "memory_mib" {
+  value = local.test.memory_mib
+}
+
+output "maximum_network_interfaces" {
+  value = local.test.maximum_network_interfaces
+}
+
+output "ipv4_addresses_per_interface" {
+  value = local.test.ipv4_addresses_per_interface
+}
+
+output "hypervisor" {
+  value = local.test.hypervisor
+}
+
+output "architecture" {
+  value = local.test.architecture
+}
+`, instanceType)
+}
+
+func testEC2InstanceFamilyInfoFunctionConfig_unrecordedDetails(instanceType string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::ec2_instance_family_info(%[1]q)
+}
+
+output "family" {
+  value = local.test.family
+}
+
+output "size" {
+  value = local.test.size
+}
+
+output "maximum_network_interfaces" {
+  value = local.test.maximum_network_interfaces
+}
+
+output "ipv4_addresses_per_interface" {
+  value = local.test.ipv4_addresses_per_interface
+}
+
+output "hypervisor" {
+  value = local.test.hypervisor
+}
+
+output "vcpus_null" {
+  value = local.test.vcpus == null
+}
+
+output "memory_mib_null" {
+  value = local.test.memory_mib == null
+}
+
+output "architecture_null" {
+  value = local.test.architecture == null
+}
+`, instanceType)
+}
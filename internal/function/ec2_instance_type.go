@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	// EKS maximum Pods reference:
+	// https://docs.aws.amazon.com/eks/latest/userguide/choosing-instance-type.html#determine-max-pods
+	// https://github.com/awslabs/amazon-eks-ami/blob/main/templates/al2/runtime/eni-max-pods.txt
+
+	// eksIPv4AddressesPerPrefix is the number of IPv4 addresses in each /28 prefix assigned with prefix delegation
+	eksIPv4AddressesPerPrefix = 16
+	// eksHostNetworkPods is the number of Pods that use the node's own IP address (aws-node and kube-proxy)
+	eksHostNetworkPods = 2
+	// eksMaxPodsVCPUThreshold is the number of vCPUs below which the recommended maximum Pods is lower
+	eksMaxPodsVCPUThreshold = 30
+	// eksMaxPodsSmallInstance is the recommended maximum Pods for instance types with fewer vCPUs than the threshold
+	eksMaxPodsSmallInstance = 110
+	// eksMaxPodsLargeInstance is the recommended maximum Pods for all other instance types
+	eksMaxPodsLargeInstance = 250
+
+	ec2HypervisorNitro = "nitro"
+)
+
+var (
+	// e.g. m5.large or m7gd.xlarge
+	ec2InstanceTypeRegex = regexache.MustCompile(`^([a-z]+)([0-9]+)([0-9a-z-]*)\.([0-9a-z-]+)$`)
+	// e.g. 4xlarge or metal-24xl
+	ec2InstanceSizeXLargeRegex = regexache.MustCompile(`^(?:([0-9]+)xlarge|metal-([0-9]+)xl)$`)
+)
+
+// ec2InstanceType holds the facts about an EC2 instance type used by provider functions.
+// The table of known instance types is generated from ec2_instance_types.csv.
+// vCPUs, memoryMiB and architecture are zero-valued for instance types whose details are not recorded.
+type ec2InstanceType struct {
+	vCPUs                                      int
+	memoryMiB                                  int
+	maximumNetworkInterfaces                   int
+	defaultNetworkCardMaximumNetworkInterfaces int
+	ipv4AddressesPerInterface                  int
+	hypervisor                                 string
+	bareMetal                                  bool
+	architecture                               string
+}
+
+// ec2InstanceTypeName is the parsed form of an EC2 instance type name.
+// See https://docs.aws.amazon.com/ec2/latest/instancetypes/instance-type-names.html.
+type ec2InstanceTypeName struct {
+	family     string
+	series     string
+	generation int
+	attributes string
+	size       string
+}
+
+func findEC2InstanceType(instanceType string) (ec2InstanceType, error) {
+	v, ok := ec2InstanceTypes[instanceType]
+	if !ok {
+		return ec2InstanceType{}, fmt.Errorf("instance type %q is not known", instanceType)
+	}
+
+	return v, nil
+}
+
+func parseEC2InstanceTypeName(instanceType string) (*ec2InstanceTypeName, error) {
+	m := ec2InstanceTypeRegex.FindStringSubmatch(instanceType)
+	if m == nil {
+		return nil, fmt.Errorf("%q is not a valid instance type", instanceType)
+	}
+
+	generation, err := strconv.Atoi(m[2])
+	if err != nil {
+		return nil, err
+	}
+
+	return &ec2InstanceTypeName{
+		family:     m[1] + m[2] + m[3],
+		series:     m[1],
+		generation: generation,
+		attributes: m[3],
+		size:       m[4],
+	}, nil
+}
+
+// ec2InstanceTypeVCPUs returns the number of vCPUs of an instance type.
+// For instance types whose details are not recorded, the number is taken from the size in the instance type name,
+// where a medium size has 1 vCPU, a large size has 2 and each xlarge has 4.
+func ec2InstanceTypeVCPUs(name *ec2InstanceTypeName, v ec2InstanceType) (int, error) {
+	if v.vCPUs > 0 {
+		return v.vCPUs, nil
+	}
+
+	// High performance computing instance types have fewer vCPUs than their size suggests.
+	if name.series != "hpc" {
+		switch size := name.size; size {
+		case "medium":
+			return 1, nil
+		case "large":
+			return 2, nil
+		case "xlarge":
+			return 4, nil
+		default:
+			if m := ec2InstanceSizeXLargeRegex.FindStringSubmatch(size); m != nil {
+				n, err := strconv.Atoi(m[1] + m[2])
+				if err != nil {
+					return 0, err
+				}
+
+				return 4 * n, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("number of vCPUs of instance size %q in family %q is not known", name.size, name.family)
+}
+
+// eksMaxPods returns the maximum number of Pods that the Amazon VPC CNI plugin can run on an instance type.
+// Only the network interfaces of the default network card are available to Pods.
+// With prefix delegation, the result is limited to the number of Pods recommended for the instance type's vCPUs.
+func eksMaxPods(name *ec2InstanceTypeName, v ec2InstanceType, prefixDelegation bool) (int, error) {
+	interfaces := v.defaultNetworkCardMaximumNetworkInterfaces
+	addresses := v.ipv4AddressesPerInterface - 1 // The primary address of each interface is not available to Pods.
+
+	if !prefixDelegation {
+		return interfaces*addresses + eksHostNetworkPods, nil
+	}
+
+	// Bare metal instances are built on the Nitro System but do not report a hypervisor.
+	if v.hypervisor != ec2HypervisorNitro && !v.bareMetal {
+		return 0, errors.New("prefix delegation is only supported on instance types built on the Nitro System")
+	}
+
+	maxPods := interfaces*addresses*eksIPv4AddressesPerPrefix + eksHostNetworkPods
+
+	vCPUs, err := ec2InstanceTypeVCPUs(name, v)
+	if err != nil {
+		return 0, err
+	}
+
+	recommended := eksMaxPodsLargeInstance
+	if vCPUs < eksMaxPodsVCPUThreshold {
+		recommended = eksMaxPodsSmallInstance
+	}
+
+	return min(maxPods, recommended), nil
+}
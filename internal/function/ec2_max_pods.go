@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = ec2MaxPodsFunction{}
+
+func NewEC2MaxPodsFunction() function.Function {
+	return &ec2MaxPodsFunction{}
+}
+
+type ec2MaxPodsFunction struct{}
+
+func (f ec2MaxPodsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ec2_max_pods"
+}
+
+func (f ec2MaxPodsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ec2_max_pods Function",
+		MarkdownDescription: "Calculates the maximum number of Pods that the Amazon VPC CNI plugin for Kubernetes can run " +
+			"on an EKS node of the specified EC2 instance type.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "instance_type",
+				MarkdownDescription: "EC2 instance type, e.g. `m5.large`",
+			},
+			function.BoolParameter{
+				Name:                "cni_prefix_delegation",
+				MarkdownDescription: "Whether prefix delegation is enabled in the Amazon VPC CNI plugin",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f ec2MaxPodsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var instanceType string
+	var prefixDelegation bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &instanceType, &prefixDelegation))
+	if resp.Error != nil {
+		return
+	}
+
+	v, err := findEC2InstanceType(instanceType)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	name, err := parseEC2InstanceTypeName(instanceType)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	maxPods, err := eksMaxPods(name, v, prefixDelegation)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int64(maxPods)))
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEC2MaxPodsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2MaxPodsFunctionConfig("m5.large", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "29"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("t3.micro", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "4"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("m5.24xlarge", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "737"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("t3a.small", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "8"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("m7i.large", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "29"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("c7i.48xlarge", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "737"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("r7g.medium", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2MaxPodsFunction_multipleNetworkCards(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2MaxPodsFunctionConfig("p5.48xlarge", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "100"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("c6in.32xlarge", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "394"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2MaxPodsFunction_prefixDelegation(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2MaxPodsFunctionConfig("t3.micro", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "34"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("m5.large", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "110"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("m5.8xlarge", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "250"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("m7i.large", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "110"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("c7i.8xlarge", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "250"),
+				),
+			},
+			{
+				Config: testEC2MaxPodsFunctionConfig("m7i.metal-24xl", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "250"),
+				),
+			},
+			{
+				Config:      testEC2MaxPodsFunctionConfig("hpc7g.4xlarge", true),
+				ExpectError: regexache.MustCompile(`number[\s\n]*of[\s\n]*vCPUs`),
+			},
+			{
+				Config:      testEC2MaxPodsFunctionConfig("m4.large", true),
+				ExpectError: regexache.MustCompile(`only[\s\n]*supported[\s\n]*on[\s\n]*instance[\s\n]*types[\s\n]*built[\s\n]*on[\s\n]*the[\s\n]*Nitro`),
+			},
+		},
+	})
+}
+
+func TestEC2MaxPodsFunction_unknownInstanceType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEC2MaxPodsFunctionConfig("x9z.huge", false),
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*known`),
+			},
+		},
+	})
+}
+
+func testEC2MaxPodsFunctionConfig(instanceType string, prefixDelegation bool) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::ec2_max_pods(%[1]q, %[2]t)
+}
+`, instanceType, prefixDelegation)
+}
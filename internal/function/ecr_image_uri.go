@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// ECR registry types
+	ecrRegistryTypePrivate = "private"
+	ecrRegistryTypePublic  = "public"
+
+	// ecrPublicRegistryHost is the hostname of all ECR Public registries
+	ecrPublicRegistryHost = "public.ecr.aws"
+)
+
+var (
+	// e.g. 123456789012.dkr.ecr.us-west-2.amazonaws.com or 123456789012.dkr.ecr-fips.us-gov-west-1.amazonaws.com
+	ecrPrivateRegistryHostRegex = regexache.MustCompile(`^([0-9]{12})\.dkr\.ecr(-fips)?\.([0-9a-z-]+)\.([0-9a-z.-]+)$`)
+	// e.g. 123456789012
+	ecrRegistryIDRegex = regexache.MustCompile(`^[0-9]{12}$`)
+	// e.g. example or example_alias
+	ecrPublicRegistryAliasRegex = regexache.MustCompile(`^[0-9a-z]+(?:[._-][0-9a-z]+)*$`)
+	// e.g. example, namespace/example or docker-hub/library/nginx
+	ecrRepositoryNameRegex = regexache.MustCompile(`^(?:[0-9a-z]+(?:[._-][0-9a-z]+)*/)*[0-9a-z]+(?:[._-][0-9a-z]+)*$`)
+	// e.g. latest or v1.2.3
+	ecrImageTagRegex = regexache.MustCompile(`^[0-9A-Za-z_][0-9A-Za-z_.-]{0,127}$`)
+	// e.g. sha256:0123...cdef
+	ecrImageDigestRegex = regexache.MustCompile(`^[0-9a-z]+(?:[+._-][0-9a-z]+)*:[0-9a-f]{32,}$`)
+)
+
+// ecrImageURI is the parsed form of an ECR image URI.
+type ecrImageURI struct {
+	registryType string
+	// registryID is the AWS account ID of a private registry, or the alias of a public registry.
+	registryID string
+	region     string
+	partition  string
+	fips       bool
+	repository string
+	tag        string
+	digest     string
+}
+
+// ecrRegistryHost returns the hostname of a private registry, using the DNS suffix of the Region's partition.
+func ecrRegistryHost(registryID, region string) string {
+	dnsSuffix := names.PartitionForRegion(region).DNSSuffix()
+	if dnsSuffix == "" {
+		dnsSuffix = "amazonaws.com"
+	}
+
+	return fmt.Sprintf("%s.dkr.ecr.%s.%s", registryID, region, dnsSuffix)
+}
+
+// String returns the image URI, e.g. 123456789012.dkr.ecr.us-west-2.amazonaws.com/example:latest.
+func (u *ecrImageURI) String() string {
+	var sb strings.Builder
+
+	switch u.registryType {
+	case ecrRegistryTypePublic:
+		sb.WriteString(ecrPublicRegistryHost + "/" + u.registryID)
+	default:
+		host := ecrRegistryHost(u.registryID, u.region)
+		if u.fips {
+			host = strings.Replace(host, ".dkr.ecr.", ".dkr.ecr-fips.", 1)
+		}
+		sb.WriteString(host)
+	}
+
+	sb.WriteString("/" + u.repository)
+
+	if u.tag != "" {
+		sb.WriteString(":" + u.tag)
+	}
+	if u.digest != "" {
+		sb.WriteString("@" + u.digest)
+	}
+
+	return sb.String()
+}
+
+func (u *ecrImageURI) validate() error {
+	var errs []error
+
+	switch u.registryType {
+	case ecrRegistryTypePublic:
+		if !ecrPublicRegistryAliasRegex.MatchString(u.registryID) {
+			errs = append(errs, fmt.Errorf("%q is not a valid ECR Public registry alias", u.registryID))
+		}
+	default:
+		if !ecrRegistryIDRegex.MatchString(u.registryID) {
+			errs = append(errs, fmt.Errorf("%q is not a valid ECR registry ID", u.registryID))
+		}
+	}
+
+	if !ecrRepositoryNameRegex.MatchString(u.repository) || len(u.repository) < 2 || len(u.repository) > 256 {
+		errs = append(errs, fmt.Errorf("%q is not a valid ECR repository name", u.repository))
+	}
+	if u.tag != "" && !ecrImageTagRegex.MatchString(u.tag) {
+		errs = append(errs, fmt.Errorf("%q is not a valid image tag", u.tag))
+	}
+	if u.digest != "" && !ecrImageDigestRegex.MatchString(u.digest) {
+		errs = append(errs, fmt.Errorf("%q is not a valid image digest", u.digest))
+	}
+
+	return errors.Join(errs...)
+}
+
+// parseECRImageURI parses a private or public ECR image URI, e.g.
+//   - 123456789012.dkr.ecr.us-west-2.amazonaws.com/example:latest
+//   - 123456789012.dkr.ecr.us-west-2.amazonaws.com/example@sha256:0123...cdef
+//   - public.ecr.aws/alias/example:latest
+func parseECRImageURI(s string) (*ecrImageURI, error) {
+	host, path, ok := strings.Cut(s, "/")
+	if !ok || path == "" {
+		return nil, fmt.Errorf("%q is not a valid ECR image URI", s)
+	}
+
+	var u ecrImageURI
+
+	if host == ecrPublicRegistryHost {
+		u.registryType = ecrRegistryTypePublic
+		u.partition = endpoints.AwsPartitionID // ECR Public is only available in the aws partition.
+		u.registryID, path, _ = strings.Cut(path, "/")
+	} else {
+		m := ecrPrivateRegistryHostRegex.FindStringSubmatch(host)
+		if m == nil {
+			return nil, fmt.Errorf("%q is not a valid ECR image URI; registry %q is not an ECR registry", s, host)
+		}
+
+		partition := names.PartitionForRegion(m[3])
+		if dnsSuffix := partition.DNSSuffix(); m[4] != dnsSuffix {
+			return nil, fmt.Errorf("%q is not a valid ECR image URI; DNS suffix for Region %q must be %q", s, m[3], dnsSuffix)
+		}
+
+		u.registryType = ecrRegistryTypePrivate
+		u.registryID = m[1]
+		u.fips = m[2] != ""
+		u.region = m[3]
+		u.partition = partition.ID()
+	}
+
+	path, u.digest, _ = strings.Cut(path, "@")
+	if i := strings.LastIndex(path, ":"); i > strings.LastIndex(path, "/") {
+		path, u.tag = path[:i], path[i+1:]
+	}
+	u.repository = path
+
+	if err := u.validate(); err != nil {
+		return nil, fmt.Errorf("%q is not a valid ECR image URI: %w", s, err)
+	}
+
+	return &u, nil
+}
+
+// ecrPullThroughCacheRepository returns the upstream repository name of a pull through cache repository.
+// Returns an empty string if the repository is not within the pull through cache prefix.
+func ecrPullThroughCacheRepository(repository, prefix string) string {
+	if prefix == "" {
+		return ""
+	}
+
+	upstream, ok := strings.CutPrefix(repository, strings.TrimSuffix(prefix, "/")+"/")
+	if !ok {
+		return ""
+	}
+
+	return upstream
+}
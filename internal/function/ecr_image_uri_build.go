@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = ecrImageURIBuildFunction{}
+
+func NewECRImageURIBuildFunction() function.Function {
+	return &ecrImageURIBuildFunction{}
+}
+
+type ecrImageURIBuildFunction struct{}
+
+func (f ecrImageURIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecr_image_uri_build"
+}
+
+func (f ecrImageURIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ecr_image_uri_build Function",
+		MarkdownDescription: "Builds an ECR or ECR Public image URI from its registry, repository, tag and digest. " +
+			"The registry hostname uses the DNS suffix of the Region's partition.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "registry_id",
+				MarkdownDescription: "AWS account ID of a private registry, or the alias of an ECR Public registry",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region of a private registry. Must be empty for an ECR Public registry",
+			},
+			function.StringParameter{
+				Name:                "repository",
+				MarkdownDescription: "Repository name",
+			},
+			function.StringParameter{
+				Name:                "tag",
+				MarkdownDescription: "Image tag. May be empty",
+			},
+			function.StringParameter{
+				Name:                "digest",
+				MarkdownDescription: "Image digest, e.g. `sha256:...`. May be empty",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "pull_through_cache_prefix",
+			MarkdownDescription: "Optional pull through cache repository prefix, prepended to the repository name",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ecrImageURIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var registryID, region, repository, tag, digest string
+	var prefixes []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &registryID, &region, &repository, &tag, &digest, &prefixes))
+	if resp.Error != nil {
+		return
+	}
+
+	switch len(prefixes) {
+	case 0:
+	case 1:
+		if prefix := prefixes[0]; prefix != "" {
+			repository = strings.TrimSuffix(prefix, "/") + "/" + repository
+		}
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(5, "at most one pull_through_cache_prefix may be specified"))
+		return
+	}
+
+	uri := &ecrImageURI{
+		registryType: ecrRegistryTypePrivate,
+		registryID:   registryID,
+		region:       region,
+		repository:   repository,
+		tag:          tag,
+		digest:       digest,
+	}
+	if region == "" {
+		uri.registryType = ecrRegistryTypePublic
+	}
+
+	if err := uri.validate(); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, uri.String()))
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const testECRImageDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestECRImageURIBuildFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIBuildFunctionConfig("123456789012", "us-west-2", "example", "latest", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "123456789012.dkr.ecr.us-west-2.amazonaws.com/example:latest"),
+				),
+			},
+			{
+				Config: testECRImageURIBuildFunctionConfig("123456789012", "us-west-2", "namespace/example", "v1.2.3", testECRImageDigest),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "123456789012.dkr.ecr.us-west-2.amazonaws.com/namespace/example:v1.2.3@"+testECRImageDigest),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIBuildFunction_partition(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIBuildFunctionConfig("123456789012", "cn-north-1", "example", "", testECRImageDigest),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/example@"+testECRImageDigest),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIBuildFunction_public(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIBuildFunctionConfig("nginx", "", "nginx", "latest", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "public.ecr.aws/nginx/nginx:latest"),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIBuildFunction_pullThroughCache(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::ecr_image_uri_build("123456789012", "us-west-2", "library/nginx", "1.27", "", "docker-hub")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "123456789012.dkr.ecr.us-west-2.amazonaws.com/docker-hub/library/nginx:1.27"),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIBuildFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECRImageURIBuildFunctionConfig("1234", "us-west-2", "example", "latest", ""),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*ECR[\s\n]*registry[\s\n]*ID`),
+			},
+			{
+				Config:      testECRImageURIBuildFunctionConfig("123456789012", "us-west-2", "Example", "latest", ""),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*ECR[\s\n]*repository[\s\n]*name`),
+			},
+			{
+				Config:      testECRImageURIBuildFunctionConfig("123456789012", "us-west-2", "example", "", "sha256:invalid"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*image[\s\n]*digest`),
+			},
+		},
+	})
+}
+
+func testECRImageURIBuildFunctionConfig(registryID, region, repository, tag, digest string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::ecr_image_uri_build(%[1]q, %[2]q, %[3]q, %[4]q, %[5]q)
+}
+`, registryID, region, repository, tag, digest)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ecrImageURIParseResultAttrTypes = map[string]attr.Type{
+	"registry":            types.StringType,
+	"registry_type":       types.StringType,
+	"registry_id":         types.StringType,
+	"partition":           types.StringType,
+	"region":              types.StringType,
+	"repository":          types.StringType,
+	"upstream_repository": types.StringType,
+	"tag":                 types.StringType,
+	"digest":              types.StringType,
+}
+
+var _ function.Function = ecrImageURIParseFunction{}
+
+func NewECRImageURIParseFunction() function.Function {
+	return &ecrImageURIParseFunction{}
+}
+
+type ecrImageURIParseFunction struct{}
+
+func (f ecrImageURIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecr_image_uri_parse"
+}
+
+func (f ecrImageURIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "ecr_image_uri_parse Function",
+		MarkdownDescription: "Parses an ECR or ECR Public image URI into its registry, repository, tag and digest",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "ECR or ECR Public image URI to parse",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "pull_through_cache_prefix",
+			MarkdownDescription: "Optional pull through cache repository prefix, removed from the repository name to give the upstream repository",
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: ecrImageURIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f ecrImageURIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+	var prefixes []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &prefixes))
+	if resp.Error != nil {
+		return
+	}
+
+	var prefix string
+	switch len(prefixes) {
+	case 0:
+	case 1:
+		prefix = prefixes[0]
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "at most one pull_through_cache_prefix may be specified"))
+		return
+	}
+
+	uri, err := parseECRImageURI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	registry, _, _ := strings.Cut(arg, "/")
+	if uri.registryType == ecrRegistryTypePublic {
+		registry += "/" + uri.registryID
+	}
+
+	value := map[string]attr.Value{
+		"registry":            types.StringValue(registry),
+		"registry_type":       types.StringValue(uri.registryType),
+		"registry_id":         types.StringValue(uri.registryID),
+		"partition":           types.StringValue(uri.partition),
+		"region":              types.StringValue(uri.region),
+		"repository":          types.StringValue(uri.repository),
+		"upstream_repository": types.StringValue(ecrPullThroughCacheRepository(uri.repository, prefix)),
+		"tag":                 types.StringValue(uri.tag),
+		"digest":              types.StringValue(uri.digest),
+	}
+
+	result, d := types.ObjectValue(ecrImageURIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
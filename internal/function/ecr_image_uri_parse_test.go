@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECRImageURIParseFunction_private(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIParseFunctionConfig("123456789012.dkr.ecr.us-west-2.amazonaws.com/namespace/example:v1.2.3@" + testECRImageDigest),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("registry", "123456789012.dkr.ecr.us-west-2.amazonaws.com"),
+					resource.TestCheckOutput("registry_type", "private"),
+					resource.TestCheckOutput("registry_id", "123456789012"),
+					resource.TestCheckOutput("partition", "aws"),
+					resource.TestCheckOutput("region", "us-west-2"),
+					resource.TestCheckOutput("repository", "namespace/example"),
+					resource.TestCheckOutput("upstream_repository", ""),
+					resource.TestCheckOutput("tag", "v1.2.3"),
+					resource.TestCheckOutput("digest", testECRImageDigest),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_govCloudFIPS(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIParseFunctionConfig("123456789012.dkr.ecr-fips.us-gov-west-1.amazonaws.com/example:latest"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("registry", "123456789012.dkr.ecr-fips.us-gov-west-1.amazonaws.com"),
+					resource.TestCheckOutput("partition", "aws-us-gov"),
+					resource.TestCheckOutput("region", "us-gov-west-1"),
+					resource.TestCheckOutput("repository", "example"),
+					resource.TestCheckOutput("tag", "latest"),
+					resource.TestCheckOutput("digest", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_public(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIParseFunctionConfig("public.ecr.aws/nginx/nginx:latest"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("registry", "public.ecr.aws/nginx"),
+					resource.TestCheckOutput("registry_type", "public"),
+					resource.TestCheckOutput("registry_id", "nginx"),
+					resource.TestCheckOutput("partition", "aws"),
+					resource.TestCheckOutput("region", ""),
+					resource.TestCheckOutput("repository", "nginx"),
+					resource.TestCheckOutput("tag", "latest"),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_pullThroughCache(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::ecr_image_uri_parse("123456789012.dkr.ecr.us-west-2.amazonaws.com/docker-hub/library/nginx:1.27", "docker-hub").upstream_repository
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "library/nginx"),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECRImageURIParseFunctionConfig("docker.io/library/nginx:latest"),
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*an[\s\n]*ECR[\s\n]*registry`),
+			},
+			{
+				Config:      testECRImageURIParseFunctionConfig("123456789012.dkr.ecr.cn-north-1.amazonaws.com/example"),
+				ExpectError: regexache.MustCompile(`DNS[\s\n]*suffix[\s\n]*for[\s\n]*Region`),
+			},
+		},
+	})
+}
+
+func testECRImageURIParseFunctionConfig(uri string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::ecr_image_uri_parse(%[1]q)
+}
+
+output "registry" {
+  value = local.test.registry
+}
+
+output "registry_type" {
+  value = local.test.registry_type
+}
+
+output "registry_id" {
+  value = local.test.registry_id
+}
+
+output "partition" {
+  value = local.test.partition
+}
+
+output "region" {
+  value = local.test.region
+}
+
+output "repository" {
+  value = local.test.repository
+}
+
+output "upstream_repository" {
+  value = local.test.upstream_repository
+}
+
+output "tag" {
+  value = local.test.tag
+}
+
+output "digest" {
+  value = local.test.digest
+}
+`, uri)
+}
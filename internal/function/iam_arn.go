@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+const (
+	// IAM and STS ARN reference:
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_identifiers.html#identifiers-arns
+
+	// stsServiceSection is the expected service section of an STS ARN
+	stsServiceSection = "sts"
+
+	// stsAssumedRoleResourceType is the resource type of an STS assumed role session ARN
+	stsAssumedRoleResourceType = "assumed-role"
+)
+
+// iamARNComponents are the constituent parts of an IAM or STS ARN.
+type iamARNComponents struct {
+	partition    string
+	accountID    string
+	resourceType string
+	path         string
+	name         string
+	sessionName  string
+}
+
+// parseIAMARN parses an IAM or STS ARN into its constituent parts.
+// The path is only set for resource types that support paths.
+func parseIAMARN(s string) (*iamARNComponents, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Region != "" {
+		return nil, errors.New("region must be empty")
+	}
+
+	c := &iamARNComponents{
+		partition: v.Partition,
+		accountID: v.AccountID,
+	}
+
+	switch v.Service {
+	case serviceSection:
+		if v.Resource == "root" {
+			c.resourceType = v.Resource
+			return c, nil
+		}
+
+		resourceType, rest, ok := strings.Cut(v.Resource, "/")
+		if !ok || rest == "" {
+			return nil, fmt.Errorf("resource %q is not valid", v.Resource)
+		}
+		c.resourceType = resourceType
+
+		switch resourceType {
+		case "group", "instance-profile", "policy", "role", "server-certificate", "user":
+			i := strings.LastIndex(rest, "/")
+			c.path, c.name = "/"+rest[:i+1], rest[i+1:]
+		default:
+			c.name = rest
+		}
+
+	case stsServiceSection:
+		resourceType, rest, ok := strings.Cut(v.Resource, "/")
+		if !ok || rest == "" {
+			return nil, fmt.Errorf("resource %q is not valid", v.Resource)
+		}
+		c.resourceType = resourceType
+
+		switch resourceType {
+		case stsAssumedRoleResourceType:
+			name, sessionName, ok := strings.Cut(rest, "/")
+			if !ok || name == "" || sessionName == "" {
+				return nil, fmt.Errorf("resource %q is not valid", v.Resource)
+			}
+			c.name, c.sessionName = name, sessionName
+		default:
+			c.name = rest
+		}
+
+	default:
+		return nil, fmt.Errorf(`service must be "%s" or "%s"`, serviceSection, stsServiceSection)
+	}
+
+	if c.name == "" {
+		return nil, fmt.Errorf("resource %q is not valid", v.Resource)
+	}
+
+	return c, nil
+}
+
+// iamRoleARN returns the ARN of an IAM role.
+// An empty path is equivalent to "/".
+func iamRoleARN(partition, accountID, name, path string) (string, error) {
+	if name == "" || strings.Contains(name, "/") {
+		return "", fmt.Errorf("role name %q is not valid", name)
+	}
+
+	if path == "" {
+		path = "/"
+	}
+	if !strings.HasPrefix(path, "/") || !strings.HasSuffix(path, "/") {
+		return "", fmt.Errorf(`path %q must begin and end with "/"`, path)
+	}
+
+	return arn.ARN{
+		Partition: partition,
+		Service:   serviceSection,
+		AccountID: accountID,
+		Resource:  resourceSectionPrefix + strings.TrimPrefix(path, "/") + name,
+	}.String(), nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var iamARNComponentsResultAttrTypes = map[string]attr.Type{
+	"partition":     types.StringType,
+	"account_id":    types.StringType,
+	"resource_type": types.StringType,
+	"path":          types.StringType,
+	"name":          types.StringType,
+	"session_name":  types.StringType,
+}
+
+var _ function.Function = iamARNComponentsFunction{}
+
+func NewIAMARNComponentsFunction() function.Function {
+	return &iamARNComponentsFunction{}
+}
+
+type iamARNComponentsFunction struct{}
+
+func (f iamARNComponentsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_arn_components"
+}
+
+func (f iamARNComponentsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_arn_components Function",
+		MarkdownDescription: "Parses an IAM or STS Amazon Resource Name (ARN) into its resource type, path, name and session name",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "IAM or STS Amazon Resource Name (ARN) to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamARNComponentsResultAttrTypes,
+		},
+	}
+}
+
+func (f iamARNComponentsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	c, err := parseIAMARN(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"partition":     types.StringValue(c.partition),
+		"account_id":    types.StringValue(c.accountID),
+		"resource_type": types.StringValue(c.resourceType),
+		"path":          types.StringValue(c.path),
+		"name":          types.StringValue(c.name),
+		"session_name":  types.StringValue(c.sessionName),
+	}
+
+	result, d := types.ObjectValue(iamARNComponentsResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
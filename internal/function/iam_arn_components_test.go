@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIAMARNComponentsFunction_role(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMARNComponentsFunctionConfig("arn:aws:iam::444455556666:role/with/path/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput(names.AttrAccountID, "444455556666"),
+					resource.TestCheckOutput(names.AttrName, "example"),
+					resource.TestCheckOutput("partition", "aws"),
+					resource.TestCheckOutput(names.AttrPath, "/with/path/"),
+					resource.TestCheckOutput(names.AttrResourceType, "role"),
+					resource.TestCheckOutput("session_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMARNComponentsFunction_assumedRole(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMARNComponentsFunctionConfig("arn:aws:sts::444455556666:assumed-role/example/session"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput(names.AttrAccountID, "444455556666"),
+					resource.TestCheckOutput(names.AttrName, "example"),
+					resource.TestCheckOutput(names.AttrPath, ""),
+					resource.TestCheckOutput(names.AttrResourceType, "assumed-role"),
+					resource.TestCheckOutput("session_name", "session"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMARNComponentsFunction_invalidService(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMARNComponentsFunctionConfig("arn:aws:s3:::bucket/foo"),
+				ExpectError: expectedErrorInvalidService,
+			},
+		},
+	})
+}
+
+func testIAMARNComponentsFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::iam_arn_components(%[1]q)
+}
+
+output "account_id" {
+  value = local.result.account_id
+}
+
+output "name" {
+  value = local.result.name
+}
+
+output "partition" {
+  value = local.result.partition
+}
+
+output "path" {
+  value = local.result.path
+}
+
+output "resource_type" {
+  value = local.result.resource_type
+}
+
+output "session_name" {
+  value = local.result.session_name
+}
+`, arg)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = iamPolicyAllowsFunction{}
+
+func NewIAMPolicyAllowsFunction() function.Function {
+	return &iamPolicyAllowsFunction{}
+}
+
+type iamPolicyAllowsFunction struct{}
+
+func (f iamPolicyAllowsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_allows"
+}
+
+func (f iamPolicyAllowsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_allows Function",
+		MarkdownDescription: "Determines whether an IAM policy document allows an action on a resource. The policy is " +
+			"evaluated offline: an explicit `Deny` overrides any `Allow`, and requests that no statement allows are " +
+			"implicitly denied. `Principal` and `NotPrincipal` elements are not evaluated.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Action to evaluate, e.g. `s3:GetObject`",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "ARN of the resource to evaluate",
+			},
+			function.MapParameter{
+				Name:                "context",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				AllowNullValue:      true,
+				MarkdownDescription: "Request context keys and their values, used to evaluate conditions and policy variables",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyAllowsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy, action, resource string
+	var contextValue types.Map
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy, &action, &resource, &contextValue))
+	if resp.Error != nil {
+		return
+	}
+
+	requestContext := make(map[string][]string, len(contextValue.Elements()))
+	for key, value := range contextValue.Elements() {
+		list, ok := value.(types.List)
+		if !ok {
+			continue
+		}
+
+		var values []string
+		for _, v := range list.Elements() {
+			if s, ok := v.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+				values = append(values, s.ValueString())
+			}
+		}
+		requestContext[key] = values
+	}
+
+	doc, err := parseIAMPolicy(policy)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	allowed, err := evaluateIAMPolicy(doc, newIAMPolicyRequest(action, resource, requestContext))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, allowed))
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const testIAMPolicyAllowsFunctionPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:Get*", "s3:ListBucket"],
+      "Resource": ["arn:aws:s3:::example/*", "arn:aws:s3:::example/home/${aws:username}/*"]
+    },
+    {
+      "Effect": "Deny",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::example/secret/*"
+    },
+    {
+      "Effect": "Allow",
+      "NotAction": "iam:*",
+      "Resource": "*",
+      "Condition": {
+        "StringEquals": {"aws:RequestedRegion": ["us-east-1", "us-west-2"]},
+        "Bool": {"aws:SecureTransport": "true"}
+      }
+    },
+    {
+      "Effect": "Deny",
+      "Action": "ec2:*",
+      "Resource": "*",
+      "Condition": {
+        "NotIpAddress": {"aws:SourceIp": "10.0.0.0/8"}
+      }
+    }
+  ]
+}`
+
+func TestIAMPolicyAllowsFunction_allow(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyAllowsFunctionConfig(testIAMPolicyAllowsFunctionPolicy, "S3:GetObject", "arn:aws:s3:::example/key", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyAllowsFunction_explicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyAllowsFunctionConfig(testIAMPolicyAllowsFunctionPolicy, "s3:GetObject", "arn:aws:s3:::example/secret/key", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyAllowsFunction_implicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyAllowsFunctionConfig(testIAMPolicyAllowsFunctionPolicy, "s3:PutObject", "arn:aws:s3:::example/key", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyAllowsFunction_policyVariable(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyAllowsFunctionConfig(testIAMPolicyAllowsFunctionPolicy, "s3:ListBucket", "arn:aws:s3:::example/home/alice/key", `{ "aws:username" = ["alice"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyAllowsFunction_conditions(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyAllowsFunctionConfig(testIAMPolicyAllowsFunctionPolicy, "sqs:SendMessage", "*", `{ "aws:RequestedRegion" = ["us-west-2"], "aws:SecureTransport" = ["true"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testIAMPolicyAllowsFunctionConfig(testIAMPolicyAllowsFunctionPolicy, "sqs:SendMessage", "*", `{ "aws:RequestedRegion" = ["eu-west-1"], "aws:SecureTransport" = ["true"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testIAMPolicyAllowsFunctionConfig(testIAMPolicyAllowsFunctionPolicy, "iam:CreateUser", "*", `{ "aws:RequestedRegion" = ["us-west-2"], "aws:SecureTransport" = ["true"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testIAMPolicyAllowsFunctionConfig(testIAMPolicyAllowsFunctionPolicy, "ec2:DescribeInstances", "*", `{ "aws:RequestedRegion" = ["us-west-2"], "aws:SecureTransport" = ["true"], "aws:SourceIp" = ["192.0.2.1"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyAllowsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyAllowsFunctionConfig("invalid", "s3:GetObject", "*", "null"),
+				ExpectError: regexache.MustCompile(`parsing[\s\n]*policy`),
+			},
+			{
+				Config:      testIAMPolicyAllowsFunctionConfig(`{"Statement":{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringMatches":{"aws:username":"alice"}}}}`, "s3:GetObject", "*", "null"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*condition[\s\n]*operator`),
+			},
+		},
+	})
+}
+
+const testIAMPolicyAllowsFunctionConfigFmt = `
+output "test" {
+  value = provider::aws::iam_policy_allows(%[1]q, %[2]q, %[3]q, %[4]s)
+}
+`
+
+func testIAMPolicyAllowsFunctionConfig(policy, action, resource, context string) string {
+	return fmt.Sprintf(testIAMPolicyAllowsFunctionConfigFmt, policy, action, resource, context)
+}
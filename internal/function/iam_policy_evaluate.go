@@ -0,0 +1,520 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// IAM policy evaluation logic reference:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_evaluation-logic.html
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html
+
+// iamPolicyRequest is the request context against which an IAM policy is evaluated.
+type iamPolicyRequest struct {
+	action   string
+	resource string
+	// context maps lower-cased condition keys to their values.
+	context map[string][]string
+}
+
+func newIAMPolicyRequest(action, resource string, context map[string][]string) *iamPolicyRequest {
+	request := &iamPolicyRequest{
+		action:   strings.ToLower(action),
+		resource: resource,
+		context:  make(map[string][]string, len(context)),
+	}
+
+	// Condition keys are case-insensitive.
+	for k, v := range context {
+		request.context[strings.ToLower(k)] = v
+	}
+
+	return request
+}
+
+// evaluateIAMPolicy returns whether a policy allows a request.
+// A request is allowed if at least one statement allows it and no statement denies it.
+// Principal and NotPrincipal elements are not evaluated.
+func evaluateIAMPolicy(doc *iamPolicyDocument, request *iamPolicyRequest) (bool, error) {
+	allowed := false
+
+	// IAM only accepts the exact values "Allow" and "Deny".
+	for i, statement := range doc.Statement {
+		if statement.Effect != "Allow" && statement.Effect != "Deny" {
+			return false, fmt.Errorf("evaluating policy statement %d: Effect must be \"Allow\" or \"Deny\", got %q", i, statement.Effect)
+		}
+	}
+
+	for i, statement := range doc.Statement {
+		matches, err := statement.matches(request)
+		if err != nil {
+			return false, fmt.Errorf("evaluating policy statement %d: %w", i, err)
+		}
+
+		if !matches {
+			continue
+		}
+
+		if statement.Effect == "Deny" {
+			// An explicit deny overrides any allow.
+			return false, nil
+		}
+
+		allowed = true
+	}
+
+	return allowed, nil
+}
+
+func (statement iamPolicyStatement) matches(request *iamPolicyRequest) (bool, error) {
+	switch {
+	case statement.Action != nil:
+		if !anyIAMPolicyPatternMatches(iamPolicyActionPatterns(statement.Action), request.action, nil) {
+			return false, nil
+		}
+	case statement.NotAction != nil:
+		if anyIAMPolicyPatternMatches(iamPolicyActionPatterns(statement.NotAction), request.action, nil) {
+			return false, nil
+		}
+	default:
+		return false, fmt.Errorf("one of Action or NotAction is required")
+	}
+
+	switch {
+	case statement.Resource != nil:
+		if !anyIAMPolicyPatternMatches(statement.Resource, request.resource, request.context) {
+			return false, nil
+		}
+	case statement.NotResource != nil:
+		if anyIAMPolicyPatternMatches(statement.NotResource, request.resource, request.context) {
+			return false, nil
+		}
+	}
+
+	// All condition operators and all keys within each operator must be satisfied.
+	for operator, block := range statement.Condition {
+		for key, values := range block {
+			ok, err := evaluateIAMPolicyCondition(operator, iamPolicyValueStrings(values), request.context[strings.ToLower(key)], request.context)
+			if err != nil {
+				return false, fmt.Errorf("Condition %s %s: %w", operator, key, err)
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// iamPolicyValueStrings returns the values of a normalized policy element.
+func iamPolicyValueStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	}
+
+	return nil
+}
+
+// iamPolicyActionPatterns returns the lower-cased values of an Action or NotAction element.
+// Action names are case-insensitive.
+func iamPolicyActionPatterns(v any) []string {
+	var patterns []string
+
+	for _, v := range iamPolicyValueStrings(v) {
+		patterns = append(patterns, strings.ToLower(v))
+	}
+
+	return patterns
+}
+
+// anyIAMPolicyPatternMatches returns whether any of the specified wildcard patterns match a value.
+// If context is non-nil, policy variables in the patterns are substituted.
+func anyIAMPolicyPatternMatches(patterns any, value string, context map[string][]string) bool {
+	for _, pattern := range iamPolicyValueStrings(patterns) {
+		if context != nil {
+			var ok bool
+			if pattern, ok = substituteIAMPolicyVariables(pattern, context); !ok {
+				continue
+			}
+		}
+
+		if iamPolicyWildcardMatch(pattern, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// substituteIAMPolicyVariables replaces policy variables, e.g. ${aws:username}, with single-valued context values.
+// Returns false if a variable without a default value has no value in the request context.
+func substituteIAMPolicyVariables(s string, context map[string][]string) (string, bool) {
+	var sb strings.Builder
+
+	for {
+		start := strings.Index(s, "${")
+		if start < 0 {
+			sb.WriteString(s)
+			return sb.String(), true
+		}
+		end := strings.Index(s[start:], "}")
+		if end < 0 {
+			sb.WriteString(s)
+			return sb.String(), true
+		}
+		end += start
+
+		sb.WriteString(s[:start])
+		variable := s[start+2 : end]
+		s = s[end+1:]
+
+		// Special characters.
+		switch variable {
+		case "*", "?", "$":
+			sb.WriteString(escapeIAMPolicyWildcards(variable))
+			continue
+		}
+
+		key, defaultValue, hasDefault := strings.Cut(variable, ",")
+		key = strings.TrimSpace(key)
+		if hasDefault {
+			defaultValue = strings.Trim(strings.TrimSpace(defaultValue), `'`)
+		}
+
+		if v := context[strings.ToLower(key)]; len(v) == 1 {
+			sb.WriteString(escapeIAMPolicyWildcards(v[0]))
+		} else if hasDefault {
+			sb.WriteString(escapeIAMPolicyWildcards(defaultValue))
+		} else {
+			return "", false
+		}
+	}
+}
+
+const (
+	// Substituted values match literally, so wildcard characters within them are replaced with
+	// private use characters that iamPolicyWildcardMatch treats as the literal character.
+	iamPolicyLiteralMultiCharacter  = '\uE000'
+	iamPolicyLiteralSingleCharacter = '\uE001'
+)
+
+var iamPolicyWildcardEscaper = strings.NewReplacer("*", string(iamPolicyLiteralMultiCharacter), "?", string(iamPolicyLiteralSingleCharacter))
+
+var iamPolicyWildcardUnescaper = strings.NewReplacer(string(iamPolicyLiteralMultiCharacter), "*", string(iamPolicyLiteralSingleCharacter), "?")
+
+func escapeIAMPolicyWildcards(s string) string {
+	return iamPolicyWildcardEscaper.Replace(s)
+}
+
+func unescapeIAMPolicyWildcards(s string) string {
+	return iamPolicyWildcardUnescaper.Replace(s)
+}
+
+// iamPolicyWildcardMatch returns whether a value matches a pattern containing
+// multi-character (*) and single-character (?) wildcards.
+func iamPolicyWildcardMatch(pattern, value string) bool {
+	ps, vs := []rune(pattern), []rune(value)
+	p, v := 0, 0
+	starP, starV := -1, 0
+
+	for v < len(vs) {
+		switch {
+		case p < len(ps) && ps[p] == '*':
+			starP, starV = p, v
+			p++
+		case p < len(ps) && (ps[p] == '?' || iamPolicyLiteralRune(ps[p]) == vs[v]):
+			p++
+			v++
+		case starP >= 0:
+			p = starP + 1
+			starV++
+			v = starV
+		default:
+			return false
+		}
+	}
+
+	for p < len(ps) && ps[p] == '*' {
+		p++
+	}
+
+	return p == len(ps)
+}
+
+func iamPolicyLiteralRune(r rune) rune {
+	switch r {
+	case iamPolicyLiteralMultiCharacter:
+		return '*'
+	case iamPolicyLiteralSingleCharacter:
+		return '?'
+	}
+
+	return r
+}
+
+// evaluateIAMPolicyCondition evaluates a single condition key against the request context.
+func evaluateIAMPolicyCondition(operator string, conditionValues, contextValues []string, context map[string][]string) (bool, error) {
+	var forAllValues, forAnyValue bool
+	if v, ok := strings.CutPrefix(operator, "ForAllValues:"); ok {
+		operator, forAllValues = v, true
+	} else if v, ok := strings.CutPrefix(operator, "ForAnyValue:"); ok {
+		operator, forAnyValue = v, true
+	}
+
+	if operator == "Null" {
+		if len(conditionValues) != 1 {
+			return false, fmt.Errorf("exactly one value is required")
+		}
+		null, err := strconv.ParseBool(conditionValues[0])
+		if err != nil {
+			return false, err
+		}
+		return null == (len(contextValues) == 0), nil
+	}
+
+	operator, ifExists := strings.CutSuffix(operator, "IfExists")
+
+	compare, negated, err := iamPolicyConditionComparator(operator)
+	if err != nil {
+		return false, err
+	}
+
+	if len(contextValues) == 0 {
+		switch {
+		case ifExists, forAllValues:
+			return true, nil
+		case forAnyValue:
+			return false, nil
+		}
+
+		// Negated operators match when the key is not present in the request context.
+		return negated, nil
+	}
+
+	// matchesAny returns whether a context value matches any of the condition values.
+	matchesAny := func(contextValue string) (bool, error) {
+		for _, conditionValue := range conditionValues {
+			conditionValue, ok := substituteIAMPolicyVariables(conditionValue, context)
+			if !ok {
+				continue
+			}
+
+			ok, err := compare(conditionValue, contextValue)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	if forAllValues {
+		// Every context value must satisfy the condition.
+		for _, contextValue := range contextValues {
+			ok, err := matchesAny(contextValue)
+			if err != nil {
+				return false, err
+			}
+			if ok == negated {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+
+	// At least one context value must satisfy the condition.
+	// For negated operators, no context value may match any of the condition values.
+	for _, contextValue := range contextValues {
+		ok, err := matchesAny(contextValue)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return !negated, nil
+		}
+	}
+
+	return negated, nil
+}
+
+type iamPolicyComparator func(conditionValue, contextValue string) (bool, error)
+
+// iamPolicyConditionComparator returns the comparison function for a condition operator,
+// and whether the operator is the negation of that comparison.
+func iamPolicyConditionComparator(operator string) (iamPolicyComparator, bool, error) {
+	switch operator {
+	case "StringEquals", "BinaryEquals":
+		return stringEqualsComparator, false, nil
+	case "StringNotEquals":
+		return stringEqualsComparator, true, nil
+	case "StringEqualsIgnoreCase":
+		return stringEqualsIgnoreCaseComparator, false, nil
+	case "StringNotEqualsIgnoreCase":
+		return stringEqualsIgnoreCaseComparator, true, nil
+	case "StringLike":
+		return stringLikeComparator, false, nil
+	case "StringNotLike":
+		return stringLikeComparator, true, nil
+	case "NumericEquals":
+		return numericComparator(func(c int) bool { return c == 0 }), false, nil
+	case "NumericNotEquals":
+		return numericComparator(func(c int) bool { return c == 0 }), true, nil
+	case "NumericLessThan":
+		return numericComparator(func(c int) bool { return c < 0 }), false, nil
+	case "NumericLessThanEquals":
+		return numericComparator(func(c int) bool { return c <= 0 }), false, nil
+	case "NumericGreaterThan":
+		return numericComparator(func(c int) bool { return c > 0 }), false, nil
+	case "NumericGreaterThanEquals":
+		return numericComparator(func(c int) bool { return c >= 0 }), false, nil
+	case "DateEquals":
+		return dateComparator(func(c int) bool { return c == 0 }), false, nil
+	case "DateNotEquals":
+		return dateComparator(func(c int) bool { return c == 0 }), true, nil
+	case "DateLessThan":
+		return dateComparator(func(c int) bool { return c < 0 }), false, nil
+	case "DateLessThanEquals":
+		return dateComparator(func(c int) bool { return c <= 0 }), false, nil
+	case "DateGreaterThan":
+		return dateComparator(func(c int) bool { return c > 0 }), false, nil
+	case "DateGreaterThanEquals":
+		return dateComparator(func(c int) bool { return c >= 0 }), false, nil
+	case "Bool":
+		return boolComparator, false, nil
+	case "IpAddress":
+		return ipAddressComparator, false, nil
+	case "NotIpAddress":
+		return ipAddressComparator, true, nil
+	case "ArnEquals", "ArnLike":
+		return arnLikeComparator, false, nil
+	case "ArnNotEquals", "ArnNotLike":
+		return arnLikeComparator, true, nil
+	}
+
+	return nil, false, fmt.Errorf("unsupported condition operator %q", operator)
+}
+
+func stringEqualsComparator(conditionValue, contextValue string) (bool, error) {
+	return contextValue == unescapeIAMPolicyWildcards(conditionValue), nil
+}
+
+func stringEqualsIgnoreCaseComparator(conditionValue, contextValue string) (bool, error) {
+	return strings.EqualFold(contextValue, unescapeIAMPolicyWildcards(conditionValue)), nil
+}
+
+func stringLikeComparator(conditionValue, contextValue string) (bool, error) {
+	return iamPolicyWildcardMatch(conditionValue, contextValue), nil
+}
+
+func boolComparator(conditionValue, contextValue string) (bool, error) {
+	return strings.EqualFold(contextValue, conditionValue), nil
+}
+
+// numericComparator returns a comparator that applies f to the result of comparing the context value with the condition value.
+func numericComparator(f func(int) bool) iamPolicyComparator {
+	return func(conditionValue, contextValue string) (bool, error) {
+		c, err := strconv.ParseFloat(conditionValue, 64)
+		if err != nil {
+			return false, err
+		}
+		v, err := strconv.ParseFloat(contextValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("request context value: %w", err)
+		}
+
+		switch {
+		case v < c:
+			return f(-1), nil
+		case v > c:
+			return f(1), nil
+		default:
+			return f(0), nil
+		}
+	}
+}
+
+// dateComparator returns a comparator that applies f to the result of comparing the context value with the condition value.
+func dateComparator(f func(int) bool) iamPolicyComparator {
+	return func(conditionValue, contextValue string) (bool, error) {
+		c, err := parseIAMPolicyDate(conditionValue)
+		if err != nil {
+			return false, err
+		}
+		v, err := parseIAMPolicyDate(contextValue)
+		if err != nil {
+			return false, fmt.Errorf("request context value: %w", err)
+		}
+
+		return f(v.Compare(c)), nil
+	}
+}
+
+// parseIAMPolicyDate parses a date in ISO 8601 format or as a Unix epoch time in seconds.
+func parseIAMPolicyDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05Z0700", time.DateOnly} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%q is not a valid date", s)
+}
+
+func ipAddressComparator(conditionValue, contextValue string) (bool, error) {
+	prefix, err := netip.ParsePrefix(conditionValue)
+	if err != nil {
+		addr, err := netip.ParseAddr(conditionValue)
+		if err != nil {
+			return false, fmt.Errorf("%q is not a valid IP address or CIDR block", conditionValue)
+		}
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	addr, err := netip.ParseAddr(contextValue)
+	if err != nil {
+		return false, fmt.Errorf("request context value %q is not a valid IP address", contextValue)
+	}
+
+	return prefix.Contains(addr), nil
+}
+
+// arnLikeComparator compares each of the six colon-delimited components of an ARN separately.
+func arnLikeComparator(conditionValue, contextValue string) (bool, error) {
+	if !arn.IsARN(contextValue) {
+		return false, nil
+	}
+
+	conditionParts := strings.SplitN(conditionValue, ":", 6)
+	contextParts := strings.SplitN(contextValue, ":", 6)
+	if len(conditionParts) != len(contextParts) {
+		return false, nil
+	}
+
+	for i := range conditionParts {
+		if !iamPolicyWildcardMatch(conditionParts[i], contextParts[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
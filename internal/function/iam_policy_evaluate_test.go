@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"testing"
+)
+
+func TestIAMPolicyWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		"exact": {
+			pattern:  "s3:getobject",
+			value:    "s3:getobject",
+			expected: true,
+		},
+		"different": {
+			pattern: "s3:getobject",
+			value:   "s3:putobject",
+		},
+		"multi-character wildcard": {
+			pattern:  "s3:get*",
+			value:    "s3:getobjectacl",
+			expected: true,
+		},
+		"multi-character wildcard empty": {
+			pattern:  "s3:get*",
+			value:    "s3:get",
+			expected: true,
+		},
+		"multi-character wildcard middle": {
+			pattern:  "arn:aws:s3:::example/*/key",
+			value:    "arn:aws:s3:::example/a/b/key",
+			expected: true,
+		},
+		"multi-character wildcard no match": {
+			pattern: "arn:aws:s3:::example/*/key",
+			value:   "arn:aws:s3:::example/a/b/other",
+		},
+		"single-character wildcard": {
+			pattern:  "ec2:describe?pcs",
+			value:    "ec2:describevpcs",
+			expected: true,
+		},
+		"single-character wildcard no match": {
+			pattern: "ec2:describe?pcs",
+			value:   "ec2:describepcs",
+		},
+		"all": {
+			pattern:  "*",
+			value:    "iam:createuser",
+			expected: true,
+		},
+		"literal wildcard": {
+			pattern: string(iamPolicyLiteralMultiCharacter),
+			value:   "x",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := iamPolicyWildcardMatch(testCase.pattern, testCase.value), testCase.expected; got != want {
+				t.Errorf("iamPolicyWildcardMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.value, got, want)
+			}
+		})
+	}
+}
+
+func TestEvaluateIAMPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		action   string
+		resource string
+		context  map[string][]string
+		expected bool
+	}{
+		"allow": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}}`,
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::example/key",
+			expected: true,
+		},
+		"implicit deny": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}}`,
+			action:   "s3:PutObject",
+			resource: "arn:aws:s3:::example/key",
+		},
+		"action case-insensitive": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"S3:Get*","Resource":"*"}}`,
+			action:   "s3:getObject",
+			resource: "arn:aws:s3:::example/key",
+			expected: true,
+		},
+		"resource case-sensitive": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::Example/*"}}`,
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::example/key",
+		},
+		"deny overrides allow": {
+			policy:   `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"},{"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/secret/*"}]}`,
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::example/secret/key",
+		},
+		"deny does not match": {
+			policy:   `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"},{"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/secret/*"}]}`,
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::example/public/key",
+			expected: true,
+		},
+		"NotAction": {
+			policy:   `{"Statement":{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}}`,
+			action:   "ec2:DescribeVpcs",
+			resource: "*",
+			expected: true,
+		},
+		"NotAction excluded": {
+			policy:   `{"Statement":{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}}`,
+			action:   "iam:CreateUser",
+			resource: "*",
+		},
+		"NotResource": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::example/secret/*"}}`,
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::example/public/key",
+			expected: true,
+		},
+		"NotResource excluded": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::example/secret/*"}}`,
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::example/secret/key",
+		},
+		"Deny with NotAction": {
+			policy:   `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"},{"Effect":"Deny","NotAction":"s3:*","Resource":"*"}]}`,
+			action:   "ec2:RunInstances",
+			resource: "*",
+		},
+		"policy variable": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::example/home/${aws:username}/*"}}`,
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::example/home/alice/key",
+			context:  map[string][]string{"aws:username": {"alice"}},
+			expected: true,
+		},
+		"policy variable missing": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::example/home/${aws:username}/*"}}`,
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::example/home/alice/key",
+		},
+		"policy variable default": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::example/home/${aws:username, 'guest'}/*"}}`,
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::example/home/guest/key",
+			expected: true,
+		},
+		"condition satisfied": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":["us-east-1","us-west-2"]},"Bool":{"aws:SecureTransport":true}}}}`,
+			action:   "sqs:SendMessage",
+			resource: "*",
+			context:  map[string][]string{"aws:requestedregion": {"us-west-2"}, "AWS:SecureTransport": {"true"}},
+			expected: true,
+		},
+		"condition not satisfied": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":["us-east-1","us-west-2"]},"Bool":{"aws:SecureTransport":true}}}}`,
+			action:   "sqs:SendMessage",
+			resource: "*",
+			context:  map[string][]string{"aws:requestedregion": {"us-west-2"}, "aws:SecureTransport": {"false"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := parseIAMPolicy(testCase.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := evaluateIAMPolicy(doc, newIAMPolicyRequest(testCase.action, testCase.resource, testCase.context))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.expected; got != want {
+				t.Errorf("evaluateIAMPolicy = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestEvaluateIAMPolicy_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"no action":            `{"Statement":{"Effect":"Allow","Resource":"*"}}`,
+		"unsupported effect":   `{"Statement":{"Effect":"Maybe","Action":"*","Resource":"*"}}`,
+		"lower-case effect":    `{"Statement":{"Effect":"allow","Action":"*","Resource":"*"}}`,
+		"unmatched effect":     `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"},{"Effect":"DENY","Action":"iam:*","Resource":"*"}]}`,
+		"unsupported operator": `{"Statement":{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringMatches":{"aws:username":"alice"}}}}`,
+	}
+
+	for name, policy := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := parseIAMPolicy(policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _, err := evaluateIAMPolicy(doc, newIAMPolicyRequest("s3:GetObject", "*", map[string][]string{"aws:username": {"alice"}})); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestEvaluateIAMPolicyCondition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operator        string
+		conditionValues []string
+		contextValues   []string
+		expected        bool
+	}{
+		"StringEquals": {
+			operator:        "StringEquals",
+			conditionValues: []string{"alice", "bob"},
+			contextValues:   []string{"bob"},
+			expected:        true,
+		},
+		"StringEquals case-sensitive": {
+			operator:        "StringEquals",
+			conditionValues: []string{"alice"},
+			contextValues:   []string{"Alice"},
+		},
+		"StringEquals missing key": {
+			operator:        "StringEquals",
+			conditionValues: []string{"alice"},
+		},
+		"StringNotEquals": {
+			operator:        "StringNotEquals",
+			conditionValues: []string{"alice"},
+			contextValues:   []string{"bob"},
+			expected:        true,
+		},
+		"StringNotEquals missing key": {
+			operator:        "StringNotEquals",
+			conditionValues: []string{"alice"},
+			expected:        true,
+		},
+		"StringEqualsIgnoreCase": {
+			operator:        "StringEqualsIgnoreCase",
+			conditionValues: []string{"alice"},
+			contextValues:   []string{"ALICE"},
+			expected:        true,
+		},
+		"StringLike": {
+			operator:        "StringLike",
+			conditionValues: []string{"home/*"},
+			contextValues:   []string{"home/alice"},
+			expected:        true,
+		},
+		"StringNotLike": {
+			operator:        "StringNotLike",
+			conditionValues: []string{"home/*"},
+			contextValues:   []string{"home/alice"},
+		},
+		"StringEqualsIfExists missing key": {
+			operator:        "StringEqualsIfExists",
+			conditionValues: []string{"alice"},
+			expected:        true,
+		},
+		"StringEqualsIfExists present": {
+			operator:        "StringEqualsIfExists",
+			conditionValues: []string{"alice"},
+			contextValues:   []string{"bob"},
+		},
+		"NumericLessThan": {
+			operator:        "NumericLessThan",
+			conditionValues: []string{"10"},
+			contextValues:   []string{"9.5"},
+			expected:        true,
+		},
+		"NumericGreaterThanEquals": {
+			operator:        "NumericGreaterThanEquals",
+			conditionValues: []string{"10"},
+			contextValues:   []string{"9"},
+		},
+		"DateLessThan": {
+			operator:        "DateLessThan",
+			conditionValues: []string{"2025-01-01T00:00:00Z"},
+			contextValues:   []string{"2024-12-31"},
+			expected:        true,
+		},
+		"DateGreaterThan epoch": {
+			operator:        "DateGreaterThan",
+			conditionValues: []string{"2025-01-01T00:00:00Z"},
+			contextValues:   []string{"1735689601"},
+			expected:        true,
+		},
+		"Bool": {
+			operator:        "Bool",
+			conditionValues: []string{"true"},
+			contextValues:   []string{"TRUE"},
+			expected:        true,
+		},
+		"IpAddress": {
+			operator:        "IpAddress",
+			conditionValues: []string{"192.0.2.0/24"},
+			contextValues:   []string{"192.0.2.1"},
+			expected:        true,
+		},
+		"NotIpAddress": {
+			operator:        "NotIpAddress",
+			conditionValues: []string{"192.0.2.0/24"},
+			contextValues:   []string{"198.51.100.1"},
+			expected:        true,
+		},
+		"ArnLike": {
+			operator:        "ArnLike",
+			conditionValues: []string{"arn:aws:iam::*:role/example-*"},
+			contextValues:   []string{"arn:aws:iam::123456789012:role/example-role"},
+			expected:        true,
+		},
+		"ArnLike not an ARN": {
+			operator:        "ArnLike",
+			conditionValues: []string{"arn:aws:iam::*:role/example-*"},
+			contextValues:   []string{"example-role"},
+		},
+		"Null true": {
+			operator:        "Null",
+			conditionValues: []string{"true"},
+			expected:        true,
+		},
+		"Null false": {
+			operator:        "Null",
+			conditionValues: []string{"false"},
+			contextValues:   []string{"alice"},
+			expected:        true,
+		},
+		"ForAllValues": {
+			operator:        "ForAllValues:StringEquals",
+			conditionValues: []string{"a", "b"},
+			contextValues:   []string{"a", "b"},
+			expected:        true,
+		},
+		"ForAllValues not all": {
+			operator:        "ForAllValues:StringEquals",
+			conditionValues: []string{"a", "b"},
+			contextValues:   []string{"a", "c"},
+		},
+		"ForAllValues missing key": {
+			operator:        "ForAllValues:StringEquals",
+			conditionValues: []string{"a"},
+			expected:        true,
+		},
+		"ForAnyValue": {
+			operator:        "ForAnyValue:StringEquals",
+			conditionValues: []string{"a"},
+			contextValues:   []string{"c", "a"},
+			expected:        true,
+		},
+		"ForAnyValue missing key": {
+			operator:        "ForAnyValue:StringEquals",
+			conditionValues: []string{"a"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := evaluateIAMPolicyCondition(testCase.operator, testCase.conditionValues, testCase.contextValues, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.expected; got != want {
+				t.Errorf("evaluateIAMPolicyCondition(%q) = %t, want %t", testCase.operator, got, want)
+			}
+		})
+	}
+}
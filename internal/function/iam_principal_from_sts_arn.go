@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = iamPrincipalFromSTSARNFunction{}
+
+func NewIAMPrincipalFromSTSARNFunction() function.Function {
+	return &iamPrincipalFromSTSARNFunction{}
+}
+
+type iamPrincipalFromSTSARNFunction struct{}
+
+func (f iamPrincipalFromSTSARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_principal_from_sts_arn"
+}
+
+func (f iamPrincipalFromSTSARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_principal_from_sts_arn Function",
+		MarkdownDescription: "Converts an STS assumed role session Amazon Resource Name (ARN) into the ARN of the underlying IAM role. " +
+			"STS ARNs do not include the role's path, so a path may optionally be specified. IAM ARNs are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "STS assumed role session or IAM Amazon Resource Name (ARN)",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "path",
+			MarkdownDescription: "Optional role path. Must begin and end with `/`",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPrincipalFromSTSARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+	var paths []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &paths))
+	if resp.Error != nil {
+		return
+	}
+
+	var path string
+	switch len(paths) {
+	case 0:
+	case 1:
+		path = paths[0]
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "at most one path may be specified"))
+		return
+	}
+
+	result, err := iamPrincipalFromSTSARN(arg, path)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// iamPrincipalFromSTSARN returns the IAM role ARN for an STS assumed role session ARN.
+// IAM ARNs are returned unchanged.
+func iamPrincipalFromSTSARN(s, path string) (string, error) {
+	c, err := parseIAMARN(s)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case c.resourceType == stsAssumedRoleResourceType:
+		return iamRoleARN(c.partition, c.accountID, c.name, path)
+	case path != "":
+		return "", fmt.Errorf("path can only be specified for STS %s ARNs", stsAssumedRoleResourceType)
+	case c.resourceType == "federated-user":
+		return "", errors.New("STS federated-user ARNs do not correspond to an IAM principal")
+	}
+
+	return s, nil
+}
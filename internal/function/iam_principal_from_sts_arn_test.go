@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPrincipalFromSTSARNFunction_assumedRole(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPrincipalFromSTSARNFunctionConfig("arn:aws:sts::444455556666:assumed-role/example/session"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:iam::444455556666:role/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPrincipalFromSTSARNFunction_assumedRoleWithPath(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPrincipalFromSTSARNFunctionConfigWithPath("arn:aws:sts::444455556666:assumed-role/example/session", "/with/path/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:iam::444455556666:role/with/path/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPrincipalFromSTSARNFunction_iamRole(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPrincipalFromSTSARNFunctionConfig("arn:aws:iam::444455556666:role/with/path/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:iam::444455556666:role/with/path/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPrincipalFromSTSARNFunction_federatedUser(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPrincipalFromSTSARNFunctionConfig("arn:aws:sts::444455556666:federated-user/example"),
+				ExpectError: regexache.MustCompile(`do[\s\n]*not[\s\n]*correspond`),
+			},
+		},
+	})
+}
+
+func TestIAMPrincipalFromSTSARNFunction_invalidService(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPrincipalFromSTSARNFunctionConfig("arn:aws:s3:::bucket/foo"),
+				ExpectError: expectedErrorInvalidService,
+			},
+		},
+	})
+}
+
+func testIAMPrincipalFromSTSARNFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_principal_from_sts_arn(%[1]q)
+}
+`, arg)
+}
+
+func testIAMPrincipalFromSTSARNFunctionConfigWithPath(arg, path string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_principal_from_sts_arn(%[1]q, %[2]q)
+}
+`, arg, path)
+}
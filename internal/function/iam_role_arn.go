@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = iamRoleARNFunction{}
+
+func NewIAMRoleARNFunction() function.Function {
+	return &iamRoleARNFunction{}
+}
+
+type iamRoleARNFunction struct{}
+
+func (f iamRoleARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_role_arn"
+}
+
+func (f iamRoleARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_role_arn Function",
+		MarkdownDescription: "Builds an IAM role Amazon Resource Name (ARN) from its partition, account ID, name and path",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "partition",
+				MarkdownDescription: "Partition in which the role is located",
+			},
+			function.StringParameter{
+				Name:                "account_id",
+				MarkdownDescription: "AWS account identifier",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Role name",
+			},
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "Role path. Must begin and end with `/`. An empty path is equivalent to `/`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamRoleARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var partition, accountID, name, path string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &partition, &accountID, &name, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := iamRoleARN(partition, accountID, name, path)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMRoleARNFunction_noPath(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMRoleARNFunctionConfig("aws", "444455556666", "example", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:iam::444455556666:role/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMRoleARNFunction_withPath(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMRoleARNFunctionConfig("aws-us-gov", "444455556666", "example", "/with/path/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws-us-gov:iam::444455556666:role/with/path/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMRoleARNFunction_invalidPath(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMRoleARNFunctionConfig("aws", "444455556666", "example", "with/path"),
+				ExpectError: regexache.MustCompile(`path[\s\n]*"with/path"[\s\n]*must[\s\n]*begin[\s\n]*and[\s\n]*end`),
+			},
+		},
+	})
+}
+
+func testIAMRoleARNFunctionConfig(partition, accountID, name, path string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_role_arn(%[1]q, %[2]q, %[3]q, %[4]q)
+}
+`, partition, accountID, name, path)
+}
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	// A principal of the form 123456789012 is equivalent to arn:<partition>:iam::123456789012:root.
+	iamPolicyAccountIDPrincipalRegex = regexache.MustCompile(`^[0-9]{12}$`)
+)
+
+var _ function.Function = normalizeIAMPolicyFunction{}
+
+func NewNormalizeIAMPolicyFunction() function.Function {
+	return &normalizeIAMPolicyFunction{}
+}
+
+type normalizeIAMPolicyFunction struct{}
+
+func (f normalizeIAMPolicyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_iam_policy"
+}
+
+func (f normalizeIAMPolicyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "normalize_iam_policy Function",
+		MarkdownDescription: "Normalizes an IAM policy document into a canonical JSON string. Statements are sorted, " +
+			"single-element arrays are collapsed, duplicate values are removed, actions are lower-cased and account ID " +
+			"principals are converted to root user ARNs. Policies that `iam_policy_equal` considers equivalent normalize to the same string.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "partition",
+			MarkdownDescription: "Optional partition used to convert account ID principals to root user ARNs. Defaults to `aws`",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f normalizeIAMPolicyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+	var partitions []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &partitions))
+	if resp.Error != nil {
+		return
+	}
+
+	partition := endpoints.AwsPartitionID
+	switch len(partitions) {
+	case 0:
+	case 1:
+		if _, ok := partitionForID(partitions[0]); !ok {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("unknown partition %q", partitions[0])))
+			return
+		}
+		partition = partitions[0]
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "at most one partition may be specified"))
+		return
+	}
+
+	result, err := normalizeIAMPolicy(arg, partition)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// iamPolicyDocument is the canonical form of an IAM policy document.
+// Field order determines the order of keys in the normalized JSON.
+type iamPolicyDocument struct {
+	Version   string               `json:",omitempty"`
+	Id        string               `json:",omitempty"`
+	Statement []iamPolicyStatement `json:",omitempty"`
+}
+
+type iamPolicyStatement struct {
+	Sid          string                    `json:",omitempty"`
+	Effect       string                    `json:",omitempty"`
+	Principal    any                       `json:",omitempty"`
+	NotPrincipal any                       `json:",omitempty"`
+	Action       any                       `json:",omitempty"`
+	NotAction    any                       `json:",omitempty"`
+	Resource     any                       `json:",omitempty"`
+	NotResource  any                       `json:",omitempty"`
+	Condition    map[string]map[string]any `json:",omitempty"`
+}
+
+// normalizeIAMPolicy returns the canonical JSON representation of an IAM policy document.
+// In addition to the structural normalization applied by parseIAMPolicy, the element values that
+// verify.PolicyStringsEquivalent compares loosely are canonicalized, so that equivalent policies
+// normalize to the same string.
+// Empty documents ("" and "{}") normalize to "{}".
+func normalizeIAMPolicy(s, partition string) (string, error) {
+	doc, err := parseIAMPolicy(s)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range doc.Statement {
+		canonicalizeIAMPolicyStatement(&doc.Statement[i], partition)
+	}
+
+	if err := sortIAMPolicyStatements(doc); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// parseIAMPolicy decodes an IAM policy document and normalizes each of its elements.
+func parseIAMPolicy(s string) (*iamPolicyDocument, error) {
+	if v := strings.TrimSpace(s); v == "" || v == "{}" {
+		return &iamPolicyDocument{}, nil
+	}
+
+	var raw struct {
+		Version   string          `json:",omitempty"`
+		Id        string          `json:",omitempty"`
+		Statement json.RawMessage `json:",omitempty"`
+	}
+	if err := decodeStrictJSON([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	var rawStatements []json.RawMessage
+	switch v := bytes.TrimSpace(raw.Statement); {
+	case len(v) == 0, bytes.Equal(v, []byte("null")):
+	case v[0] == '[':
+		if err := json.Unmarshal(v, &rawStatements); err != nil {
+			return nil, fmt.Errorf("parsing policy statements: %w", err)
+		}
+	default:
+		rawStatements = append(rawStatements, v)
+	}
+
+	doc := &iamPolicyDocument{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	for i, v := range rawStatements {
+		statement, err := normalizeIAMPolicyStatement(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing policy statement %d: %w", i, err)
+		}
+		doc.Statement = append(doc.Statement, statement)
+	}
+
+	return doc, nil
+}
+
+// sortIAMPolicyStatements sorts a policy document's statements. Statement order is not significant.
+func sortIAMPolicyStatements(doc *iamPolicyDocument) error {
+	var errs []error
+	slices.SortStableFunc(doc.Statement, func(a, b iamPolicyStatement) int {
+		ja, err := json.Marshal(a)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		jb, err := json.Marshal(b)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return bytes.Compare(ja, jb)
+	})
+
+	return errors.Join(errs...)
+}
+
+func normalizeIAMPolicyStatement(b []byte) (iamPolicyStatement, error) {
+	var statement iamPolicyStatement
+	var err error
+
+	if err := decodeStrictJSON(b, &statement); err != nil {
+		return statement, err
+	}
+
+	if statement.Action, err = normalizeIAMPolicyValues(statement.Action); err != nil {
+		return statement, fmt.Errorf("Action: %w", err)
+	}
+	if statement.NotAction, err = normalizeIAMPolicyValues(statement.NotAction); err != nil {
+		return statement, fmt.Errorf("NotAction: %w", err)
+	}
+	if statement.Resource, err = normalizeIAMPolicyValues(statement.Resource); err != nil {
+		return statement, fmt.Errorf("Resource: %w", err)
+	}
+	if statement.NotResource, err = normalizeIAMPolicyValues(statement.NotResource); err != nil {
+		return statement, fmt.Errorf("NotResource: %w", err)
+	}
+	if statement.Principal, err = normalizeIAMPolicyPrincipal(statement.Principal); err != nil {
+		return statement, fmt.Errorf("Principal: %w", err)
+	}
+	if statement.NotPrincipal, err = normalizeIAMPolicyPrincipal(statement.NotPrincipal); err != nil {
+		return statement, fmt.Errorf("NotPrincipal: %w", err)
+	}
+
+	for operator, block := range statement.Condition {
+		for key, values := range block {
+			if block[key], err = normalizeIAMPolicyValues(values); err != nil {
+				return statement, fmt.Errorf("Condition %s %s: %w", operator, key, err)
+			}
+			if block[key] == nil {
+				delete(block, key)
+			}
+		}
+		if len(block) == 0 {
+			delete(statement.Condition, operator)
+		}
+	}
+	if len(statement.Condition) == 0 {
+		statement.Condition = nil
+	}
+
+	return statement, nil
+}
+
+// canonicalizeIAMPolicyStatement canonicalizes the values of a normalized policy statement:
+//   - Effect is matched case-insensitively, so "allow" and "deny" are written as "Allow" and "Deny"
+//   - Action and NotAction values are case-insensitive and are lower-cased
+//   - Account ID principals are converted to the equivalent root user ARN in the specified partition
+func canonicalizeIAMPolicyStatement(statement *iamPolicyStatement, partition string) {
+	for _, effect := range []string{"Allow", "Deny"} {
+		if strings.EqualFold(statement.Effect, effect) {
+			statement.Effect = effect
+		}
+	}
+
+	statement.Action = mapIAMPolicyValues(statement.Action, strings.ToLower)
+	statement.NotAction = mapIAMPolicyValues(statement.NotAction, strings.ToLower)
+
+	accountIDPrincipalToARN := func(s string) string {
+		if !iamPolicyAccountIDPrincipalRegex.MatchString(s) {
+			return s
+		}
+
+		return arn.ARN{
+			Partition: partition,
+			Service:   "iam",
+			AccountID: s,
+			Resource:  "root",
+		}.String()
+	}
+	statement.Principal = mapIAMPolicyPrincipal(statement.Principal, accountIDPrincipalToARN)
+	statement.NotPrincipal = mapIAMPolicyPrincipal(statement.NotPrincipal, accountIDPrincipalToARN)
+}
+
+// mapIAMPolicyPrincipal applies f to each value of a normalized Principal or NotPrincipal element.
+func mapIAMPolicyPrincipal(v any, f func(string) string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, values := range v {
+			v[key] = mapIAMPolicyValues(values, f)
+		}
+		return v
+	default:
+		return mapIAMPolicyValues(v, f)
+	}
+}
+
+// mapIAMPolicyValues applies f to each value of a normalized single string or array of strings.
+// The result is de-duplicated and sorted.
+func mapIAMPolicyValues(v any, f func(string) string) any {
+	switch v := v.(type) {
+	case string:
+		return f(v)
+	case []string:
+		values := make([]string, 0, len(v))
+		for _, s := range v {
+			values = append(values, f(s))
+		}
+		slices.Sort(values)
+		values = slices.Compact(values)
+		if len(values) == 1 {
+			return values[0]
+		}
+		return values
+	default:
+		return v
+	}
+}
+
+// normalizeIAMPolicyPrincipal normalizes a Principal or NotPrincipal element.
+func normalizeIAMPolicyPrincipal(v any) (any, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return v, nil
+	case map[string]any:
+		principals := make(map[string]any, len(v))
+		for key, values := range v {
+			values, err := normalizeIAMPolicyValues(values)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			if values != nil {
+				principals[key] = values
+			}
+		}
+		if len(principals) == 0 {
+			return nil, nil
+		}
+		return principals, nil
+	default:
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+// normalizeIAMPolicyValues normalizes a value that may be either a single string or an array of strings.
+// Values are de-duplicated and sorted. Single-element arrays are collapsed to a string.
+func normalizeIAMPolicyValues(v any) (any, error) {
+	var values []string
+
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		for _, v := range v {
+			s, err := iamPolicyValueString(v)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, s)
+		}
+	default:
+		s, err := iamPolicyValueString(v)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, s)
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	default:
+		return values, nil
+	}
+}
+
+// iamPolicyValueString returns the string form of a scalar policy value.
+// Condition values may be written as JSON booleans or numbers.
+func iamPolicyValueString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+func decodeStrictJSON(b []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(v)
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestNormalizeIAMPolicyFunction_basic(t *testing.T) {
+	t.Parallel()
+	arg := `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::b/*","arn:aws:s3:::a/*","arn:aws:s3:::a/*"],"Principal":{"AWS":["*"]}}}`
+	expected := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:getobject","Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"]}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testNormalizeIAMPolicyFunctionConfig(arg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestNormalizeIAMPolicyFunction_statementOrder(t *testing.T) {
+	t.Parallel()
+	arg := `{"Statement":[{"Effect":"Deny","Action":"*","Resource":"*"},{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+	expected := `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"},{"Effect":"Deny","Action":"*","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testNormalizeIAMPolicyFunctionConfig(arg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestNormalizeIAMPolicyFunction_canonicalValues(t *testing.T) {
+	t.Parallel()
+	arg := `{"Statement":[{"Effect":"allow","Action":["S3:GetObject","s3:getobject","s3:ListBucket"],"Resource":"*","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:root"]}}]}`
+	expected := `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["s3:getobject","s3:listbucket"],"Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testNormalizeIAMPolicyFunctionConfig(arg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestNormalizeIAMPolicyFunction_partition(t *testing.T) {
+	t.Parallel()
+	arg := `{"Statement":{"Effect":"Deny","NotPrincipal":{"AWS":"123456789012"},"Action":"*","Resource":"*"}}`
+	expected := `{"Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"arn:aws-cn:iam::123456789012:root"},"Action":"*","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testNormalizeIAMPolicyFunctionConfig_partition(arg, "aws-cn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+			{
+				Config:      testNormalizeIAMPolicyFunctionConfig_partition(arg, "aws-invalid"),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*partition`),
+			},
+		},
+	})
+}
+
+// TestNormalizeIAMPolicyFunction_equivalent verifies that policies that iam_policy_equal considers
+// equivalent normalize to the same string.
+func TestNormalizeIAMPolicyFunction_equivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy1 string
+		policy2 string
+	}{
+		"formatting": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{ "Statement" : { "Resource" : ["*"], "Action" : ["s3:GetObject"], "Effect" : "Allow" }, "Version" : "2012-10-17" }`,
+		},
+		"statement order": {
+			policy1: `{"Statement":[{"Effect":"Deny","Action":"*","Resource":"*"},{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"},{"Effect":"Deny","Action":"*","Resource":"*"}]}`,
+		},
+		"value order": {
+			policy1: `{"Statement":{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["arn:aws:s3:::b/*","arn:aws:s3:::a/*"]}}`,
+			policy2: `{"Statement":{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"]}}`,
+		},
+		"effect case": {
+			policy1: `{"Statement":{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}}`,
+			policy2: `{"Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+		},
+		"account ID principal": {
+			policy1: `{"Statement":{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"123456789012"}}}`,
+			policy2: `{"Statement":{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":["arn:aws:iam::123456789012:root"]}}}`,
+		},
+		"condition values": {
+			policy1: `{"Statement":{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":true}}}}`,
+			policy2: `{"Statement":{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":["true"]}}}}`,
+		},
+		"empty": {
+			policy1: ``,
+			policy2: `{}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testNormalizeIAMPolicyFunctionConfig_equivalent(testCase.policy1, testCase.policy2),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("equal", acctest.CtTrue),
+							resource.TestCheckOutput("normalized_equal", acctest.CtTrue),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestNormalizeIAMPolicyFunction_empty(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testNormalizeIAMPolicyFunctionConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "{}"),
+				),
+			},
+		},
+	})
+}
+
+func TestNormalizeIAMPolicyFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testNormalizeIAMPolicyFunctionConfig(`{"Statement":[{"Effect":"Allow","Actions":"*"}]}`),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*field`),
+			},
+		},
+	})
+}
+
+func testNormalizeIAMPolicyFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::normalize_iam_policy(%[1]q)
+}
+`, arg)
+}
+
+func testNormalizeIAMPolicyFunctionConfig_partition(arg, partition string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::normalize_iam_policy(%[1]q, %[2]q)
+}
+`, arg, partition)
+}
+
+func testNormalizeIAMPolicyFunctionConfig_equivalent(policy1, policy2 string) string {
+	return fmt.Sprintf(`
+output "equal" {
+  value = provider::aws::iam_policy_equal(%[1]q, %[2]q)
+}
+
+output "normalized_equal" {
+  value = provider::aws::normalize_iam_policy(%[1]q) == provider::aws::normalize_iam_policy(%[2]q)
+}
+`, policy1, policy2)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+// partitionForID returns the partition with the specified ID.
+func partitionForID(id string) (endpoints.Partition, bool) {
+	for _, p := range endpoints.DefaultPartitions() {
+		if p.ID() == id {
+			return p, true
+		}
+	}
+
+	return endpoints.Partition{}, false
+}
+
+// partitionForRegion returns the partition containing the specified Region.
+// Unlike names.PartitionForRegion, an error is returned if no known partition includes the Region.
+func partitionForRegion(region string) (endpoints.Partition, error) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		return endpoints.Partition{}, fmt.Errorf("no known partition includes Region %q", region)
+	}
+
+	return partition, nil
+}
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// S3 URI formats
+	s3URIFormatS3            = "s3"
+	s3URIFormatARN           = "arn"
+	s3URIFormatVirtualHosted = "virtual_hosted"
+	s3URIFormatPathStyle     = "path_style"
+)
+
+const (
+	// S3 bucket types
+	s3BucketTypeGeneralPurpose         = "general_purpose"
+	s3BucketTypeDirectory              = "directory"
+	s3BucketTypeAccessPoint            = "access_point"
+	s3BucketTypeMultiRegionAccessPoint = "multi_region_access_point"
+)
+
+var (
+	// e.g. example-bucket or example.bucket
+	s3BucketNameRegex = regexache.MustCompile(`^[0-9a-z][0-9a-z.-]{1,61}[0-9a-z]$`)
+	// e.g. example--usw2-az2--x-s3
+	s3DirectoryBucketNameRegex = regexache.MustCompile(`^[0-9a-z.-]+--([0-9a-z]+(?:-[0-9a-z]+)+)--x-s3$`)
+	// e.g. example-123456789012.s3-accesspoint.us-west-2
+	s3AccessPointHostRegex = regexache.MustCompile(`^([0-9a-z-]+)-([0-9]{12})\.s3-accesspoint(?:\.dualstack)?\.([0-9a-z-]+)$`)
+	// e.g. mfzwi23gnjvgw.mrap.accesspoint.s3-global
+	s3MultiRegionAccessPointHostRegex = regexache.MustCompile(`^([0-9a-z]+\.mrap)\.accesspoint\.s3-global$`)
+	// e.g. example--usw2-az2--x-s3.s3express-usw2-az2.us-west-2
+	s3ExpressHostRegex = regexache.MustCompile(`^(.+)\.s3express-[0-9a-z-]+\.([0-9a-z-]+)$`)
+	// e.g. example.s3-website-us-west-2 or example.s3-website.eu-central-1
+	s3WebsiteHostRegex = regexache.MustCompile(`^(.+)\.s3-website[.-]([0-9a-z-]+)$`)
+	// e.g. example.s3.us-west-2, example.s3-us-west-2, example.s3.dualstack.us-west-2 or example.s3
+	s3VirtualHostedHostRegex = regexache.MustCompile(`^(.+)\.s3(?:[.-]dualstack)?(?:[.-]([0-9a-z-]+))?$`)
+	// e.g. s3.us-west-2, s3-us-west-2, s3.dualstack.us-west-2 or s3
+	s3PathStyleHostRegex = regexache.MustCompile(`^s3(?:[.-]dualstack)?(?:[.-]([0-9a-z-]+))?$`)
+)
+
+// s3URI is the parsed form of an S3 URI, URL or ARN.
+type s3URI struct {
+	partition   string
+	region      string
+	accountID   string
+	bucket      string
+	bucketType  string
+	accessPoint string
+	key         string
+}
+
+// parseS3URI parses any of the following forms of S3 location:
+//   - s3://bucket/key
+//   - https://bucket.s3.region.amazonaws.com/key (virtual-hosted style, including directory buckets and access points)
+//   - https://s3.region.amazonaws.com/bucket/key (path style)
+//   - http://bucket.s3-website-region.amazonaws.com/key (static website endpoint)
+//   - arn:aws:s3:::bucket/key (bucket or object ARN)
+//   - arn:aws:s3:region:account-id:accesspoint/name/object/key (access point ARN)
+//   - arn:aws:s3::account-id:accesspoint/alias.mrap/object/key (Multi-Region Access Point ARN)
+//   - arn:aws:s3express:region:account-id:bucket/bucket--azid--x-s3 (directory bucket ARN)
+func parseS3URI(s string) (*s3URI, error) {
+	switch {
+	case strings.HasPrefix(s, "s3://"):
+		bucket, key, _ := strings.Cut(strings.TrimPrefix(s, "s3://"), "/")
+		if arn.IsARN(bucket) {
+			// e.g. s3://arn:aws:s3:us-west-2:123456789012:accesspoint/example/key
+			return nil, errors.New("S3 URIs containing access point ARNs are not supported; use the access point ARN directly")
+		}
+		return newS3BucketURI("", "", bucket, key)
+
+	case arn.IsARN(s):
+		return parseS3ARN(s)
+
+	case strings.HasPrefix(s, "https://"), strings.HasPrefix(s, "http://"):
+		return parseS3URL(s)
+	}
+
+	return nil, fmt.Errorf("%q is not a valid S3 URI, URL or ARN", s)
+}
+
+func newS3BucketURI(partition, region, bucket, key string) (*s3URI, error) {
+	if !s3BucketNameRegex.MatchString(bucket) {
+		return nil, fmt.Errorf("%q is not a valid S3 bucket name", bucket)
+	}
+
+	uri := &s3URI{
+		partition:  partition,
+		region:     region,
+		bucket:     bucket,
+		bucketType: s3BucketTypeGeneralPurpose,
+		key:        key,
+	}
+
+	if s3DirectoryBucketNameRegex.MatchString(bucket) {
+		uri.bucketType = s3BucketTypeDirectory
+	}
+
+	return uri, nil
+}
+
+func parseS3ARN(s string) (*s3URI, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v.Service {
+	case "s3":
+		if resource, ok := strings.CutPrefix(v.Resource, "accesspoint/"); ok {
+			name, key, _ := strings.Cut(resource, "/")
+			if key != "" {
+				var ok bool
+				if key, ok = strings.CutPrefix(key, "object/"); !ok {
+					return nil, fmt.Errorf("%q is not a valid S3 access point object ARN", s)
+				}
+			}
+			if name == "" || v.AccountID == "" {
+				return nil, fmt.Errorf("%q is not a valid S3 access point ARN", s)
+			}
+
+			uri := &s3URI{
+				partition:   v.Partition,
+				region:      v.Region,
+				accountID:   v.AccountID,
+				bucketType:  s3BucketTypeAccessPoint,
+				accessPoint: name,
+				key:         key,
+			}
+
+			if v.Region == "" {
+				uri.bucketType = s3BucketTypeMultiRegionAccessPoint
+			}
+
+			return uri, nil
+		}
+
+		if v.Region != "" || v.AccountID != "" {
+			return nil, fmt.Errorf("%q is not a valid S3 bucket or object ARN", s)
+		}
+
+		bucket, key, _ := strings.Cut(v.Resource, "/")
+
+		return newS3BucketURI(v.Partition, "", bucket, key)
+
+	case "s3express":
+		bucket, ok := strings.CutPrefix(v.Resource, "bucket/")
+		if !ok || !s3DirectoryBucketNameRegex.MatchString(bucket) {
+			return nil, fmt.Errorf("%q is not a valid S3 directory bucket ARN", s)
+		}
+
+		uri, err := newS3BucketURI(v.Partition, v.Region, bucket, "")
+		if err != nil {
+			return nil, err
+		}
+		uri.accountID = v.AccountID
+
+		return uri, nil
+	}
+
+	return nil, fmt.Errorf(`%q is not an S3 ARN; service must be "s3" or "s3express"`, s)
+}
+
+func parseS3URL(s string) (*s3URI, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(u.Hostname())
+	path := strings.TrimPrefix(u.Path, "/")
+
+	var partition endpoints.Partition
+	for _, p := range endpoints.DefaultPartitions() {
+		if _, ok := strings.CutSuffix(host, "."+p.DNSSuffix()); ok && (partition.ID() == "" || p.ID() == endpoints.AwsPartitionID) {
+			partition = p
+		}
+	}
+	host, ok := strings.CutSuffix(host, "."+partition.DNSSuffix())
+	if !ok {
+		return nil, fmt.Errorf("%q is not an AWS S3 URL", s)
+	}
+
+	uri, err := parseS3Hostname(host, path)
+	if err != nil {
+		return nil, err
+	}
+	if uri == nil {
+		return nil, fmt.Errorf("%q is not an AWS S3 URL", s)
+	}
+
+	// Several partitions share a DNS suffix, so prefer the partition of any Region in the hostname.
+	if uri.region != "" {
+		uri.partition = names.PartitionForRegion(uri.region).ID()
+	} else {
+		uri.partition = partition.ID()
+	}
+
+	return uri, nil
+}
+
+// parseS3Hostname parses an S3 hostname, with the partition DNS suffix removed, and URL path.
+// Returns nil if the hostname is not recognized.
+func parseS3Hostname(host, path string) (*s3URI, error) {
+	if m := s3MultiRegionAccessPointHostRegex.FindStringSubmatch(host); m != nil {
+		return &s3URI{
+			bucketType:  s3BucketTypeMultiRegionAccessPoint,
+			accessPoint: m[1],
+			key:         path,
+		}, nil
+	}
+
+	if m := s3AccessPointHostRegex.FindStringSubmatch(host); m != nil {
+		return &s3URI{
+			region:      m[3],
+			accountID:   m[2],
+			bucketType:  s3BucketTypeAccessPoint,
+			accessPoint: m[1],
+			key:         path,
+		}, nil
+	}
+
+	if m := s3ExpressHostRegex.FindStringSubmatch(host); m != nil {
+		return newS3BucketURI("", m[2], m[1], path)
+	}
+
+	// Website endpoints must be checked before the more general virtual-hosted and path style hostnames.
+	if m := s3WebsiteHostRegex.FindStringSubmatch(host); m != nil {
+		return newS3BucketURI("", m[2], m[1], path)
+	}
+	if strings.HasPrefix(host, "s3-website") {
+		return nil, errors.New("S3 website endpoints do not support path-style requests; the bucket name must be part of the hostname")
+	}
+
+	if m := s3PathStyleHostRegex.FindStringSubmatch(host); m != nil {
+		bucket, key, _ := strings.Cut(path, "/")
+		return newS3BucketURI("", m[1], bucket, key)
+	}
+
+	if m := s3VirtualHostedHostRegex.FindStringSubmatch(host); m != nil {
+		return newS3BucketURI("", m[2], m[1], path)
+	}
+
+	return nil, nil
+}
+
+// s3URLPath escapes an object key for use as a URL path, preserving "/" separators.
+func s3URLPath(key string) string {
+	parts := strings.Split(key, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = s3URIBuildFunction{}
+
+func NewS3URIBuildFunction() function.Function {
+	return &s3URIBuildFunction{}
+}
+
+type s3URIBuildFunction struct{}
+
+func (f s3URIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_build"
+}
+
+func (f s3URIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_uri_build Function",
+		MarkdownDescription: "Builds an S3 URI, URL or ARN for a bucket, directory bucket, access point or Multi-Region Access Point " +
+			"and an optional object key. URL hostnames use the DNS suffix of the partition containing the specified Region.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "Bucket name, or access point or Multi-Region Access Point ARN",
+			},
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "Object key. May be empty",
+			},
+			function.StringParameter{
+				Name:                "format",
+				MarkdownDescription: "Format of the result. One of `s3`, `arn`, `virtual_hosted` or `path_style`",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(s3URIFormatS3, s3URIFormatARN, s3URIFormatVirtualHosted, s3URIFormatPathStyle),
+				},
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code. Required for the `virtual_hosted` and `path_style` formats unless `bucket` is an access point ARN. May be empty",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3URIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket, key, format, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bucket, &key, &format, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	var uri *s3URI
+	var err error
+	if arn.IsARN(bucket) {
+		uri, err = parseS3ARN(bucket)
+		if err == nil && uri.bucketType != s3BucketTypeAccessPoint && uri.bucketType != s3BucketTypeMultiRegionAccessPoint {
+			err = fmt.Errorf("%q is not an S3 access point or Multi-Region Access Point ARN", bucket)
+		}
+		if err == nil && uri.key != "" {
+			err = fmt.Errorf("%q must not contain an object key", bucket)
+		}
+	} else {
+		uri, err = newS3BucketURI("", "", bucket, "")
+	}
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	uri.key = key
+
+	result, err := buildS3URI(uri, format, region)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func buildS3URI(uri *s3URI, format, region string) (string, error) {
+	isAccessPoint := uri.bucketType == s3BucketTypeAccessPoint || uri.bucketType == s3BucketTypeMultiRegionAccessPoint
+
+	// The Region of an access point ARN takes precedence.
+	if uri.region != "" {
+		region = uri.region
+	}
+	if region == "" && (format == s3URIFormatVirtualHosted || format == s3URIFormatPathStyle) && uri.bucketType != s3BucketTypeMultiRegionAccessPoint {
+		return "", fmt.Errorf("region is required for the %q format", format)
+	}
+
+	var partition endpoints.Partition
+	switch {
+	case uri.partition != "":
+		var ok bool
+		if partition, ok = partitionForID(uri.partition); !ok {
+			return "", fmt.Errorf("unknown partition %q", uri.partition)
+		}
+	case region != "":
+		partition = names.PartitionForRegion(region)
+	default:
+		partition, _ = partitionForID(endpoints.AwsPartitionID)
+	}
+	dnsSuffix := partition.DNSSuffix()
+
+	switch format {
+	case s3URIFormatS3:
+		if isAccessPoint {
+			return "", errors.New(`access points are not supported by the "s3" format`)
+		}
+
+		if uri.key == "" {
+			return "s3://" + uri.bucket, nil
+		}
+
+		return fmt.Sprintf("s3://%s/%s", uri.bucket, uri.key), nil
+
+	case s3URIFormatARN:
+		switch uri.bucketType {
+		case s3BucketTypeAccessPoint, s3BucketTypeMultiRegionAccessPoint:
+			if uri.accountID == "" {
+				return "", errors.New("access point ARNs require an AWS account ID")
+			}
+
+			resource := "accesspoint/" + uri.accessPoint
+			if uri.key != "" {
+				resource += "/object/" + uri.key
+			}
+
+			return arn.ARN{
+				Partition: partition.ID(),
+				Service:   "s3",
+				Region:    uri.region,
+				AccountID: uri.accountID,
+				Resource:  resource,
+			}.String(), nil
+
+		case s3BucketTypeDirectory:
+			return "", errors.New(`directory buckets are not supported by the "arn" format as their ARNs include an AWS account ID`)
+		}
+
+		resource := uri.bucket
+		if uri.key != "" {
+			resource += "/" + uri.key
+		}
+
+		return arn.ARN{
+			Partition: partition.ID(),
+			Service:   "s3",
+			Resource:  resource,
+		}.String(), nil
+
+	case s3URIFormatVirtualHosted:
+		var host string
+
+		switch uri.bucketType {
+		case s3BucketTypeAccessPoint:
+			host = fmt.Sprintf("%s-%s.s3-accesspoint.%s.%s", uri.accessPoint, uri.accountID, region, dnsSuffix)
+		case s3BucketTypeMultiRegionAccessPoint:
+			host = fmt.Sprintf("%s.accesspoint.s3-global.%s", uri.accessPoint, dnsSuffix)
+		case s3BucketTypeDirectory:
+			azID := s3DirectoryBucketNameRegex.FindStringSubmatch(uri.bucket)[1]
+			host = fmt.Sprintf("%s.s3express-%s.%s.%s", uri.bucket, azID, region, dnsSuffix)
+		default:
+			host = fmt.Sprintf("%s.s3.%s.%s", uri.bucket, region, dnsSuffix)
+		}
+
+		return (&url.URL{Scheme: "https", Host: host, RawPath: "/" + s3URLPath(uri.key), Path: "/" + uri.key}).String(), nil
+
+	case s3URIFormatPathStyle:
+		if isAccessPoint || uri.bucketType == s3BucketTypeDirectory {
+			return "", errors.New(`only general purpose buckets are supported by the "path_style" format`)
+		}
+
+		host := fmt.Sprintf("s3.%s.%s", region, dnsSuffix)
+		path := uri.bucket + "/" + uri.key
+
+		return (&url.URL{Scheme: "https", Host: host, RawPath: "/" + s3URLPath(path), Path: "/" + path}).String(), nil
+	}
+
+	return "", fmt.Errorf("unsupported format %q", format)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIBuildFunction_s3(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("example-bucket", "path/to/object.txt", "s3", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s3://example-bucket/path/to/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_arn(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("example-bucket", "path/to/object.txt", "arn", "us-gov-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws-us-gov:s3:::example-bucket/path/to/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_virtualHosted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("example-bucket", "path/to/object name.txt", "virtual_hosted", "cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example-bucket.s3.cn-north-1.amazonaws.com.cn/path/to/object%20name.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_virtualHostedDirectoryBucket(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("example--usw2-az1--x-s3", "object.txt", "virtual_hosted", "us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_virtualHostedAccessPoint(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("arn:aws:s3:eu-west-1:123456789012:accesspoint/example", "object.txt", "virtual_hosted", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example-123456789012.s3-accesspoint.eu-west-1.amazonaws.com/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_pathStyle(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("example.bucket", "object.txt", "path_style", "us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://s3.us-west-2.amazonaws.com/example.bucket/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_regionRequired(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIBuildFunctionConfig("example-bucket", "object.txt", "virtual_hosted", ""),
+				ExpectError: regexache.MustCompile(`region[\s\n]*is[\s\n]*required`),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_invalidFormat(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIBuildFunctionConfig("example-bucket", "object.txt", "https", "us-west-2"),
+				ExpectError: regexache.MustCompile(`value[\s\n]*must[\s\n]*be[\s\n]*one[\s\n]*of`),
+			},
+		},
+	})
+}
+
+func testS3URIBuildFunctionConfig(bucket, key, format, region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::s3_uri_build(%[1]q, %[2]q, %[3]q, %[4]q)
+}
+`, bucket, key, format, region)
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestS3URIParseFunction_s3URI(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://example-bucket/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput(names.AttrBucket, "example-bucket"),
+					resource.TestCheckOutput("bucket_type", "general_purpose"),
+					resource.TestCheckOutput(names.AttrKey, "path/to/object.txt"),
+					resource.TestCheckOutput("partition", ""),
+					resource.TestCheckOutput(names.AttrRegion, ""),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_virtualHostedURL(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("https://example-bucket.s3.cn-north-1.amazonaws.com.cn/path/to/object%20name.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput(names.AttrBucket, "example-bucket"),
+					resource.TestCheckOutput("bucket_type", "general_purpose"),
+					resource.TestCheckOutput(names.AttrKey, "path/to/object name.txt"),
+					resource.TestCheckOutput("partition", "aws-cn"),
+					resource.TestCheckOutput(names.AttrRegion, "cn-north-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_websiteURL(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("http://example-bucket.s3-website-us-west-2.amazonaws.com/index.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput(names.AttrBucket, "example-bucket"),
+					resource.TestCheckOutput("bucket_type", "general_purpose"),
+					resource.TestCheckOutput(names.AttrKey, "index.html"),
+					resource.TestCheckOutput("partition", "aws"),
+					resource.TestCheckOutput(names.AttrRegion, "us-west-2"),
+				),
+			},
+			{
+				Config: testS3URIParseFunctionConfig("http://www.example.com.s3-website.eu-central-1.amazonaws.com/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput(names.AttrBucket, "www.example.com"),
+					resource.TestCheckOutput("bucket_type", "general_purpose"),
+					resource.TestCheckOutput(names.AttrKey, ""),
+					resource.TestCheckOutput("partition", "aws"),
+					resource.TestCheckOutput(names.AttrRegion, "eu-central-1"),
+				),
+			},
+			{
+				Config:      testS3URIParseFunctionConfig("http://s3-website-us-west-2.amazonaws.com/example-bucket/index.html"),
+				ExpectError: regexache.MustCompile(`website[\s\n]*endpoints[\s\n]*do[\s\n]*not[\s\n]*support[\s\n]*path-style`),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_directoryBucketURL(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("https://example--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput(names.AttrBucket, "example--usw2-az1--x-s3"),
+					resource.TestCheckOutput("bucket_type", "directory"),
+					resource.TestCheckOutput(names.AttrKey, "object.txt"),
+					resource.TestCheckOutput("partition", "aws"),
+					resource.TestCheckOutput(names.AttrRegion, "us-west-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_accessPointARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("arn:aws:s3:us-west-2:123456789012:accesspoint/example/object/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("access_point", "example"),
+					resource.TestCheckOutput(names.AttrAccountID, "123456789012"),
+					resource.TestCheckOutput(names.AttrBucket, ""),
+					resource.TestCheckOutput("bucket_type", "access_point"),
+					resource.TestCheckOutput(names.AttrKey, "path/to/object.txt"),
+					resource.TestCheckOutput(names.AttrRegion, "us-west-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_multiRegionAccessPointARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("access_point", "mfzwi23gnjvgw.mrap"),
+					resource.TestCheckOutput("bucket_type", "multi_region_access_point"),
+					resource.TestCheckOutput(names.AttrRegion, ""),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("arn:aws:iam::123456789012:role/example"),
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*an[\s\n]*S3[\s\n]*ARN`),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "access_point" {
+  value = local.result.access_point
+}
+
+output "account_id" {
+  value = local.result.account_id
+}
+
+output "bucket" {
+  value = local.result.bucket
+}
+
+output "bucket_type" {
+  value = local.result.bucket_type
+}
+
+output "key" {
+  value = local.result.key
+}
+
+output "partition" {
+  value = local.result.partition
+}
+
+output "region" {
+  value = local.result.region
+}
+`, arg)
+}
@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Time zone names must resolve on systems without a time zone database.
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/duration"
+)
+
+// Schedule expression reference:
+// https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html
+
+const (
+	scheduleExpressionMinYear = 1970
+	scheduleExpressionMaxYear = 2199
+
+	scheduleAtTimeLayout = "2006-01-02T15:04:05"
+)
+
+var (
+	// e.g. cron(0 12 * * ? *), rate(5 minutes) or at(2025-01-01T00:00:00)
+	scheduleExpressionRegex = regexache.MustCompile(`^(cron|rate|at)\((.*)\)$`)
+
+	scheduleMonthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	scheduleDayNames   = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// scheduleExpression is a parsed cron(), rate() or at() schedule expression.
+type scheduleExpression interface {
+	// next returns the first time after t at which the schedule runs.
+	// Returns false if the schedule does not run after t.
+	next(t time.Time) (time.Time, bool)
+}
+
+// parseScheduleExpression parses a cron(), rate() or at() schedule expression.
+// at() expressions are evaluated in the specified location.
+func parseScheduleExpression(s string, loc *time.Location) (scheduleExpression, error) {
+	m := scheduleExpressionRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return nil, fmt.Errorf("%q is not a valid schedule expression; must be one of cron(...), rate(...) or at(...)", s)
+	}
+
+	var expr scheduleExpression
+	var err error
+
+	switch m[1] {
+	case "cron":
+		expr, err = parseCronScheduleExpression(m[2])
+	case "rate":
+		expr, err = parseRateScheduleExpression(m[2])
+	case "at":
+		expr, err = parseAtScheduleExpression(m[2], loc)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid schedule expression: %w", s, err)
+	}
+
+	return expr, nil
+}
+
+// scheduleNextRuns returns the next n times, after t, at which a schedule runs.
+func scheduleNextRuns(expr scheduleExpression, t time.Time, n int) []time.Time {
+	var result []time.Time
+
+	for range n {
+		var ok bool
+		if t, ok = expr.next(t); !ok {
+			break
+		}
+		result = append(result, t)
+	}
+
+	return result
+}
+
+// rateScheduleExpression runs at a fixed interval, starting from the time at which the schedule is created.
+type rateScheduleExpression struct {
+	interval time.Duration
+	days     duration.Duration
+}
+
+func parseRateScheduleExpression(s string) (*rateScheduleExpression, error) {
+	value, unit, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return nil, errors.New("rate must be of the form rate(value unit)")
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("rate value %q must be a positive integer", value)
+	}
+
+	singular := strings.TrimSuffix(unit, "s")
+	if n == 1 && unit != singular {
+		return nil, fmt.Errorf("rate unit %q must be singular for a value of 1", unit)
+	}
+	if n > 1 && unit == singular {
+		return nil, fmt.Errorf("rate unit %q must be plural for a value greater than 1", unit)
+	}
+
+	switch singular {
+	case "minute":
+		return &rateScheduleExpression{interval: time.Duration(n) * time.Minute}, nil
+	case "hour":
+		return &rateScheduleExpression{interval: time.Duration(n) * time.Hour}, nil
+	case "day":
+		days, err := duration.Parse(fmt.Sprintf("P%dD", n))
+		if err != nil {
+			return nil, err
+		}
+		return &rateScheduleExpression{days: days}, nil
+	}
+
+	return nil, fmt.Errorf(`rate unit %q must be one of "minute", "hour" or "day"`, unit)
+}
+
+func (r *rateScheduleExpression) next(t time.Time) (time.Time, bool) {
+	if !r.days.IsZero() {
+		// Rates are not affected by daylight saving time.
+		return duration.Add(t.UTC(), r.days).In(t.Location()), true
+	}
+
+	return t.Add(r.interval), true
+}
+
+// atScheduleExpression runs once.
+type atScheduleExpression struct {
+	time time.Time
+}
+
+func parseAtScheduleExpression(s string, loc *time.Location) (*atScheduleExpression, error) {
+	t, err := time.ParseInLocation(scheduleAtTimeLayout, strings.TrimSpace(s), loc)
+	if err != nil {
+		return nil, fmt.Errorf("at time must be of the form yyyy-mm-ddThh:mm:ss: %w", err)
+	}
+
+	return &atScheduleExpression{time: t}, nil
+}
+
+func (a *atScheduleExpression) next(t time.Time) (time.Time, bool) {
+	if !a.time.After(t) {
+		return time.Time{}, false
+	}
+
+	return a.time.In(t.Location()), true
+}
+
+// cronScheduleExpression runs at the times matching each of its six fields:
+// minutes, hours, day-of-month, month, day-of-week and year.
+type cronScheduleExpression struct {
+	minutes    []bool
+	hours      []bool
+	months     []bool
+	years      []bool
+	dayMatches func(time.Time) bool
+}
+
+func parseCronScheduleExpression(s string) (*cronScheduleExpression, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("cron expression must have 6 fields (minutes, hours, day-of-month, month, day-of-week and year), got %d", len(fields))
+	}
+
+	var cron cronScheduleExpression
+	var err error
+
+	if cron.minutes, err = parseCronField(fields[0], "minutes", 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if cron.hours, err = parseCronField(fields[1], "hours", 0, 23, nil); err != nil {
+		return nil, err
+	}
+	if cron.months, err = parseCronField(fields[3], "month", 1, 12, scheduleMonthNames); err != nil {
+		return nil, err
+	}
+	if cron.years, err = parseCronField(fields[5], "year", scheduleExpressionMinYear, scheduleExpressionMaxYear, nil); err != nil {
+		return nil, err
+	}
+
+	switch dayOfMonth, dayOfWeek := fields[2], fields[4]; {
+	case dayOfMonth == "?" && dayOfWeek == "?":
+		return nil, errors.New("one of day-of-month or day-of-week must be specified")
+	case dayOfMonth != "?" && dayOfWeek != "?":
+		return nil, errors.New(`one of day-of-month or day-of-week must be "?"`)
+	case dayOfWeek == "?":
+		cron.dayMatches, err = parseCronDayOfMonthField(dayOfMonth)
+	default:
+		cron.dayMatches, err = parseCronDayOfWeekField(dayOfWeek)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &cron, nil
+}
+
+func (c *cronScheduleExpression) next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	// Cron schedules run on minute boundaries.
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	if t.Year() < scheduleExpressionMinYear {
+		t = time.Date(scheduleExpressionMinYear, time.January, 1, 0, 0, 0, 0, loc)
+	}
+
+	for t.Year() <= scheduleExpressionMaxYear {
+		switch {
+		case !c.years[t.Year()-scheduleExpressionMinYear]:
+			t = time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+		case !c.months[int(t.Month())-1]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !c.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !c.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseCronField parses a comma-separated list of values, ranges (n-m), wildcards (*) and increments (/n)
+// into the set of matching values between minValue and maxValue.
+func parseCronField(s, name string, minValue, maxValue int, names []string) ([]bool, error) {
+	result := make([]bool, maxValue-minValue+1)
+
+	for item := range strings.SplitSeq(s, ",") {
+		start, end, err := parseCronRange(item, minValue, maxValue, names)
+		if err != nil {
+			return nil, fmt.Errorf("%s field %q: %w", name, s, err)
+		}
+
+		for _, v := range start.values(end) {
+			result[v-minValue] = true
+		}
+	}
+
+	return result, nil
+}
+
+// cronRangeStart is the start of a range of values with an optional increment.
+type cronRangeStart struct {
+	value     int
+	increment int
+}
+
+func (r cronRangeStart) values(end int) []int {
+	var result []int
+	for v := r.value; v <= end; v += r.increment {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+func parseCronRange(s string, minValue, maxValue int, names []string) (cronRangeStart, int, error) {
+	r := cronRangeStart{increment: 1}
+
+	s, increment, hasIncrement := strings.Cut(s, "/")
+	if hasIncrement {
+		v, err := strconv.Atoi(increment)
+		if err != nil || v < 1 {
+			return r, 0, fmt.Errorf("increment %q must be a positive integer", increment)
+		}
+		r.increment = v
+	}
+
+	if s == "*" {
+		r.value = minValue
+		return r, maxValue, nil
+	}
+
+	first, last, isRange := strings.Cut(s, "-")
+
+	var err error
+	if r.value, err = parseCronValue(first, minValue, maxValue, names); err != nil {
+		return r, 0, err
+	}
+
+	if !isRange {
+		if hasIncrement {
+			return r, maxValue, nil
+		}
+		return r, r.value, nil
+	}
+
+	end, err := parseCronValue(last, minValue, maxValue, names)
+	if err != nil {
+		return r, 0, err
+	}
+	if end < r.value {
+		return r, 0, fmt.Errorf("range %q must not end before it starts", s)
+	}
+
+	return r, end, nil
+}
+
+func parseCronValue(s string, minValue, maxValue int, names []string) (int, error) {
+	if i := slices.Index(names, strings.ToUpper(s)); i >= 0 {
+		return minValue + i, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < minValue || v > maxValue {
+		return 0, fmt.Errorf("value %q must be between %d and %d", s, minValue, maxValue)
+	}
+
+	return v, nil
+}
+
+// parseCronDayOfMonthField parses a day-of-month field, which additionally supports
+// the last day of the month (L) and the nearest weekday to a day of the month (nW).
+func parseCronDayOfMonthField(s string) (func(time.Time) bool, error) {
+	switch {
+	case s == "L":
+		return func(t time.Time) bool {
+			return t.Day() == daysInMonth(t)
+		}, nil
+
+	case strings.HasSuffix(s, "W"):
+		day, err := parseCronValue(strings.TrimSuffix(s, "W"), 1, 31, nil)
+		if err != nil {
+			return nil, fmt.Errorf("day-of-month field %q: %w", s, err)
+		}
+
+		return func(t time.Time) bool {
+			return t.Day() == nearestWeekday(t, day)
+		}, nil
+	}
+
+	days, err := parseCronField(s, "day-of-month", 1, 31, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(t time.Time) bool {
+		return days[t.Day()-1]
+	}, nil
+}
+
+// parseCronDayOfWeekField parses a day-of-week field (1-7 or SUN-SAT), which additionally supports
+// the last given day of the week in the month (nL) and the nth given day of the week in the month (d#n).
+func parseCronDayOfWeekField(s string) (func(time.Time) bool, error) {
+	if v, ok := strings.CutSuffix(s, "L"); ok && v != "" {
+		weekday, err := parseCronValue(v, 1, 7, scheduleDayNames)
+		if err != nil {
+			return nil, fmt.Errorf("day-of-week field %q: %w", s, err)
+		}
+
+		return func(t time.Time) bool {
+			return int(t.Weekday())+1 == weekday && t.Day()+7 > daysInMonth(t)
+		}, nil
+	}
+
+	if v, nth, ok := strings.Cut(s, "#"); ok {
+		weekday, err := parseCronValue(v, 1, 7, scheduleDayNames)
+		if err != nil {
+			return nil, fmt.Errorf("day-of-week field %q: %w", s, err)
+		}
+		n, err := strconv.Atoi(nth)
+		if err != nil || n < 1 || n > 5 {
+			return nil, fmt.Errorf("day-of-week field %q: occurrence %q must be between 1 and 5", s, nth)
+		}
+
+		return func(t time.Time) bool {
+			return int(t.Weekday())+1 == weekday && (t.Day()-1)/7+1 == n
+		}, nil
+	}
+
+	weekdays, err := parseCronField(s, "day-of-week", 1, 7, scheduleDayNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(t time.Time) bool {
+		return weekdays[t.Weekday()]
+	}, nil
+}
+
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest to the specified day of t's month,
+// without crossing into another month.
+func nearestWeekday(t time.Time, day int) int {
+	day = min(day, daysInMonth(t))
+
+	switch time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == daysInMonth(t) {
+			return day - 2
+		}
+		return day + 1
+	}
+
+	return day
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = scheduleExpressionValidateFunction{}
+
+func NewScheduleExpressionValidateFunction() function.Function {
+	return &scheduleExpressionValidateFunction{}
+}
+
+type scheduleExpressionValidateFunction struct{}
+
+func (f scheduleExpressionValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_validate"
+}
+
+func (f scheduleExpressionValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_expression_validate Function",
+		MarkdownDescription: "Validates a `cron()`, `rate()` or `at()` schedule expression, returning the expression " +
+			"unchanged if it is valid.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression to validate",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f scheduleExpressionValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := parseScheduleExpression(expression, time.UTC); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, expression))
+}
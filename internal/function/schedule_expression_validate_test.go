@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionValidateFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("cron(0 18 ? * MON-FRI *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cron(0 18 ? * MON-FRI *)"),
+				),
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("cron(0 9 L * ? *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cron(0 9 L * ? *)"),
+				),
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("rate(1 hour)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "rate(1 hour)"),
+				),
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("at(2030-01-01T00:00:00)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "at(2030-01-01T00:00:00)"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionValidateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("cron(0 12 * * *)"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*have[\s\n]*6[\s\n]*fields`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("cron(0 12 * * MON *)"),
+				ExpectError: regexache.MustCompile(`one[\s\n]*of[\s\n]*day-of-month[\s\n]*or[\s\n]*day-of-week[\s\n]*must[\s\n]*be`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("rate(1 minutes)"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*singular`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("every 5 minutes"),
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*a[\s\n]*valid[\s\n]*schedule[\s\n]*expression`),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionValidateFunctionConfig(expression string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::schedule_expression_validate(%[1]q)
+}
+`, expression)
+}
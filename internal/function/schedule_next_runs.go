@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// scheduleNextRunsMaxCount is the maximum number of run times that can be returned
+	scheduleNextRunsMaxCount = 100
+)
+
+var _ function.Function = scheduleNextRunsFunction{}
+
+func NewScheduleNextRunsFunction() function.Function {
+	return &scheduleNextRunsFunction{}
+}
+
+type scheduleNextRunsFunction struct{}
+
+func (f scheduleNextRunsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_next_runs"
+}
+
+func (f scheduleNextRunsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_next_runs Function",
+		MarkdownDescription: "Returns the next times, in RFC3339 format, at which a `cron()`, `rate()` or `at()` schedule " +
+			"expression runs. Times are calculated after the optional start time, or after the current time if no start time is specified.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "IANA time zone in which the schedule expression is evaluated, e.g. `America/New_York`. An empty string is equivalent to `UTC`",
+			},
+			function.Int64Parameter{
+				Name:                "n",
+				MarkdownDescription: fmt.Sprintf("Number of run times to return, between 1 and %d", scheduleNextRunsMaxCount),
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "start_time",
+			MarkdownDescription: "Optional time, in RFC3339 format, after which to calculate run times",
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, timezone string
+	var n int64
+	var startTimes []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &timezone, &n, &startTimes))
+	if resp.Error != nil {
+		return
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	if n < 1 || n > scheduleNextRunsMaxCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("n must be between 1 and %d", scheduleNextRunsMaxCount)))
+		return
+	}
+
+	var start time.Time
+	switch len(startTimes) {
+	case 0:
+		start = time.Now()
+	case 1:
+		if start, err = time.Parse(time.RFC3339, startTimes[0]); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, err.Error()))
+			return
+		}
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, "at most one start_time may be specified"))
+		return
+	}
+
+	expr, err := parseScheduleExpression(expression, loc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := make([]string, 0, n)
+	for _, t := range scheduleNextRuns(expr, start.In(loc), int(n)) {
+		result = append(result, t.Format(time.RFC3339))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleNextRunsFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig("cron(0 18 ? * MON-FRI *)", "UTC", 3, "2025-01-03T12:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-01-03T18:00:00Z,2025-01-06T18:00:00Z,2025-01-07T18:00:00Z"),
+				),
+			},
+			{
+				Config: testScheduleNextRunsFunctionConfig("cron(0 9 ? * 6L *)", "America/New_York", 2, "2025-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-01-31T09:00:00-05:00,2025-02-28T09:00:00-05:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig("rate(15 minutes)", "", 2, "2025-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-01-01T00:15:00Z,2025-01-01T00:30:00Z"),
+				),
+			},
+			{
+				Config: testScheduleNextRunsFunctionConfig("rate(2 days)", "America/New_York", 2, "2025-03-08T12:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-03-10T08:00:00-04:00,2025-03-12T08:00:00-04:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_at(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig("at(2025-06-01T10:00:00)", "Europe/London", 5, "2025-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-06-01T10:00:00+01:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_noStartTime(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig_noStartTime("rate(1 hour)", "UTC", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchOutput("test", regexache.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z,\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextRunsFunctionConfig("rate(5 minutes)", "Invalid/Zone", 1, "2025-01-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*time[\s\n]*zone`),
+			},
+			{
+				Config:      testScheduleNextRunsFunctionConfig("rate(5 minutes)", "UTC", 0, "2025-01-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`n[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+			{
+				Config:      testScheduleNextRunsFunctionConfig_multipleStartTimes("rate(5 minutes)", "UTC", 1, "2025-01-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`at[\s\n]*most[\s\n]*one[\s\n]*start_time`),
+			},
+		},
+	})
+}
+
+func testScheduleNextRunsFunctionConfig(expression, timezone string, n int, startTime string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_next_runs(%[1]q, %[2]q, %[3]d, %[4]q))
+}
+`, expression, timezone, n, startTime)
+}
+
+func testScheduleNextRunsFunctionConfig_noStartTime(expression, timezone string, n int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_next_runs(%[1]q, %[2]q, %[3]d))
+}
+`, expression, timezone, n)
+}
+
+func testScheduleNextRunsFunctionConfig_multipleStartTimes(expression, timezone string, n int, startTime string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_next_runs(%[1]q, %[2]q, %[3]d, %[4]q, %[4]q))
+}
+`, expression, timezone, n, startTime)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = subnetUsableHostsFunction{}
+
+func NewSubnetUsableHostsFunction() function.Function {
+	return &subnetUsableHostsFunction{}
+}
+
+type subnetUsableHostsFunction struct{}
+
+func (f subnetUsableHostsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_usable_hosts"
+}
+
+func (f subnetUsableHostsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "subnet_usable_hosts Function",
+		MarkdownDescription: "Returns the number of IP addresses available for use in a subnet CIDR block, " +
+			"excluding the five addresses AWS reserves in every subnet.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 subnet CIDR block",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f subnetUsableHostsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := subnetUsableHosts(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.NumberValue(new(big.Float).SetInt(result))))
+}
+
+// subnetUsableHosts returns the number of addresses in a subnet CIDR block less those reserved by AWS.
+func subnetUsableHosts(cidr string) (*big.Int, error) {
+	prefix, err := parseCIDRBlock(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSubnetPrefixLength(prefix); err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).Sub(prefixSize(prefix), big.NewInt(reservedAddressesPerSubnet)), nil
+}
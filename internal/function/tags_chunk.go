@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsChunkFunction{}
+
+func NewTagsChunkFunction() function.Function {
+	return &tagsChunkFunction{}
+}
+
+type tagsChunkFunction struct{}
+
+func (f tagsChunkFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_chunk"
+}
+
+func (f tagsChunkFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_chunk Function",
+		MarkdownDescription: "Splits a map of tags into a list of maps of at most the specified size, e.g. to stay " +
+			"within the maximum number of tags an API accepts per request. Tags are assigned to chunks in key order.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Map of tag keys to tag values",
+			},
+			function.Int64Parameter{
+				Name:                "size",
+				MarkdownDescription: "Maximum number of tags in each chunk",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.MapType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
+func (f tagsChunkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+	var size int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &size))
+	if resp.Error != nil {
+		return
+	}
+
+	if size < 1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "size must be at least 1"))
+		return
+	}
+
+	chunks := tftags.New(ctx, tags).Chunks(int(size))
+	result := make([]map[string]string, 0, len(chunks))
+	for _, chunk := range chunks {
+		result = append(result, chunk.Map())
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
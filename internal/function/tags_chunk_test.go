@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsChunkFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsChunkFunctionConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[{"key1":"value1","key2":"value2"},{"key3":"value3"}]`),
+				),
+			},
+			{
+				Config: testTagsChunkFunctionConfig(10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[{"key1":"value1","key2":"value2","key3":"value3"}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsChunkFunction_invalidSize(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testTagsChunkFunctionConfig(0),
+				ExpectError: regexache.MustCompile(`size[\s\n]*must[\s\n]*be[\s\n]*at[\s\n]*least[\s\n]*1`),
+			},
+		},
+	})
+}
+
+func testTagsChunkFunctionConfig(size int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::tags_chunk({
+    key1 = "value1"
+    key2 = "value2"
+    key3 = "value3"
+  }, %[1]d))
+}
+`, size)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsFilterAWSReservedFunction{}
+
+func NewTagsFilterAWSReservedFunction() function.Function {
+	return &tagsFilterAWSReservedFunction{}
+}
+
+type tagsFilterAWSReservedFunction struct{}
+
+func (f tagsFilterAWSReservedFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_filter_aws_reserved"
+}
+
+func (f tagsFilterAWSReservedFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_filter_aws_reserved Function",
+		MarkdownDescription: "Removes tags with keys beginning with the AWS reserved prefix `aws:` from a map of tags, " +
+			"in the same way the provider ignores AWS-managed tags.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Map of tag keys to tag values",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsFilterAWSReservedFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, tftags.New(ctx, tags).IgnoreAWS().Map()))
+}
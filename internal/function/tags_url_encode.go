@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsURLEncodeFunction{}
+
+func NewTagsURLEncodeFunction() function.Function {
+	return &tagsURLEncodeFunction{}
+}
+
+type tagsURLEncodeFunction struct{}
+
+func (f tagsURLEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_url_encode"
+}
+
+func (f tagsURLEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_url_encode Function",
+		MarkdownDescription: "Encodes a map of tags as a URL query string, sorted by key, e.g. for use as an " +
+			"S3 `x-amz-tagging` header.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Map of tag keys to tag values",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f tagsURLEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, tftags.New(ctx, tags).URLEncode()))
+}
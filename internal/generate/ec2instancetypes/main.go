@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"cmp"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+//go:embed file.gtpl
+var tmpl string
+
+// The source data is derived from the output of the following AWS CLI command:
+//
+//	aws ec2 describe-instance-types --query 'InstanceTypes[].[InstanceType,VCpuInfo.DefaultVCpus,MemoryInfo.SizeInMiB,NetworkInfo.MaximumNetworkInterfaces,NetworkInfo.NetworkCards[0].MaximumNetworkInterfaces,NetworkInfo.Ipv4AddressesPerInterface,Hypervisor,BareMetal,ProcessorInfo.SupportedArchitectures[-1]]' --output text
+//
+// The network columns of every instance type are kept in step with the VPC limits table used by the
+// Amazon VPC CNI plugin (https://github.com/aws/amazon-vpc-resource-controller-k8s/blob/master/pkg/aws/vpc/limits.go),
+// which is also the source of the EKS eni-max-pods table.
+// The VCPUs, MemoryMiB and Architecture columns are optional and are empty for instance types whose details are not recorded.
+type instanceTypeDatum struct {
+	InstanceType                               string
+	VCPUs                                      int
+	MemoryMiB                                  int
+	MaximumNetworkInterfaces                   int
+	DefaultNetworkCardMaximumNetworkInterfaces int
+	IPv4AddressesPerInterface                  int
+	Hypervisor                                 string
+	BareMetal                                  bool
+	Architecture                               string
+}
+
+type TemplateData struct {
+	InstanceTypes []instanceTypeDatum
+}
+
+func main() {
+	const (
+		filename     = "../../function/ec2_instance_types_gen.go"
+		dataFilename = "../../function/ec2_instance_types.csv"
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating %s", strings.TrimPrefix(filename, "../../"))
+
+	instanceTypes, err := readInstanceTypes(dataFilename)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", dataFilename, err)
+	}
+
+	td := TemplateData{}
+	td.InstanceTypes = instanceTypes
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("ec2instancetypes", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+func readInstanceTypes(filename string) ([]instanceTypeDatum, error) {
+	data, err := common.ReadAllCSVData(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var instanceTypes []instanceTypeDatum
+
+	for i, row := range data {
+		if i < 1 { // skip header
+			continue
+		}
+
+		if len(row) != 9 {
+			return nil, fmt.Errorf("row %d: expected 9 columns, got %d", i, len(row))
+		}
+
+		datum := instanceTypeDatum{
+			InstanceType: row[0],
+			Hypervisor:   row[6],
+			Architecture: row[8],
+		}
+
+		for j, v := range []*int{&datum.VCPUs, &datum.MemoryMiB, &datum.MaximumNetworkInterfaces, &datum.DefaultNetworkCardMaximumNetworkInterfaces, &datum.IPv4AddressesPerInterface} {
+			if j < 2 && row[j+1] == "" { // VCPUs and MemoryMiB are optional.
+				continue
+			}
+
+			if *v, err = strconv.Atoi(row[j+1]); err != nil {
+				return nil, fmt.Errorf("row %d (%s), column %d: %w", i, datum.InstanceType, j+2, err)
+			}
+		}
+
+		if datum.BareMetal, err = strconv.ParseBool(row[7]); err != nil {
+			return nil, fmt.Errorf("row %d (%s), column %d: %w", i, datum.InstanceType, 8, err)
+		}
+
+		instanceTypes = append(instanceTypes, datum)
+	}
+
+	slices.SortStableFunc(instanceTypes, func(a, b instanceTypeDatum) int {
+		return cmp.Compare(a.InstanceType, b.InstanceType)
+	})
+
+	return instanceTypes, nil
+}
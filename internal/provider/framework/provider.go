@@ -12,16 +12,19 @@ import (
 	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -29,8 +32,10 @@ import (
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/telemetry"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -40,13 +45,17 @@ var (
 
 var (
 	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithActions            = &frameworkProvider{}
 	_ provider.ProviderWithFunctions          = &frameworkProvider{}
 	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
 )
 
 type frameworkProvider struct {
+	actions            []func() action.Action
 	dataSources        []func() datasource.DataSource
 	ephemeralResources []func() ephemeral.EphemeralResource
+	listResources      []func() list.ListResource
 	primary            interface{ Meta() any }
 	resources          []func() resource.Resource
 	servicePackages    iter.Seq[conns.ServicePackage]
@@ -58,8 +67,10 @@ func NewProvider(ctx context.Context, primary interface{ Meta() any }) (provider
 	log.Printf("Creating Terraform AWS Provider (Framework-style)...")
 
 	provider := &frameworkProvider{
+		actions:            make([]func() action.Action, 0),
 		dataSources:        make([]func() datasource.DataSource, 0),
 		ephemeralResources: make([]func() ephemeral.EphemeralResource, 0),
+		listResources:      make([]func() list.ListResource, 0),
 		primary:            primary,
 		resources:          make([]func() resource.Resource, 0),
 		servicePackages:    primary.Meta().(*conns.AWSClient).ServicePackages(ctx),
@@ -339,6 +350,35 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"vcr": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to record AWS API calls to, or replay them from, a cassette file.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cassette_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the cassette file, without extension. Defaults to `" + vcr.DefaultCassetteName + "`.",
+						},
+						"mode": schema.StringAttribute{
+							Required: true,
+							Description: "Recording mode. Valid values are `RECORD_ONLY` and `REPLAY_ONLY`. " +
+								"Can also be configured using the `VCR_MODE` environment variable.",
+						},
+						"path": schema.StringAttribute{
+							Required: true,
+							Description: "Directory in which the cassette file is stored. " +
+								"Can also be configured using the `VCR_PATH` environment variable.",
+						},
+						"redact": schema.BoolAttribute{
+							Optional: true,
+							Description: "Whether to redact AWS account IDs and secret values from recorded API calls. " +
+								"Can also be configured using the `VCR_REDACT` environment variable.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -349,9 +389,19 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 func (p *frameworkProvider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.primary.Meta()
+	response.ActionData = v
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ListResourceData = v
+}
+
+// Actions returns a slice of functions to instantiate each Action
+// implementation.
+//
+// All actions must have unique type names.
+func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
+	return slices.Clone(p.actions)
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -378,6 +428,14 @@ func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() eph
 	return slices.Clone(p.ephemeralResources)
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// All list resources must have unique type names.
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return slices.Clone(p.listResources)
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -387,6 +445,29 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRSplitForAZsFunction,
+		tffunction.NewDNSSuffixFunction,
+		tffunction.NewEC2InstanceFamilyInfoFunction,
+		tffunction.NewEC2MaxPodsFunction,
+		tffunction.NewECRImageURIBuildFunction,
+		tffunction.NewECRImageURIParseFunction,
+		tffunction.NewIAMARNComponentsFunction,
+		tffunction.NewIAMPolicyAllowsFunction,
+		tffunction.NewIAMPolicyEqualFunction,
+		tffunction.NewIAMPrincipalFromSTSARNFunction,
+		tffunction.NewIAMRoleARNFunction,
+		tffunction.NewNormalizeIAMPolicyFunction,
+		tffunction.NewPartitionOfRegionFunction,
+		tffunction.NewS3URIBuildFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewScheduleExpressionValidateFunction,
+		tffunction.NewScheduleNextRunsFunction,
+		tffunction.NewServicePrincipalFunction,
+		tffunction.NewSubnetUsableHostsFunction,
+		tffunction.NewTagsChunkFunction,
+		tffunction.NewTagsFilterAWSReservedFunction,
+		tffunction.NewTagsURLEncodeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -430,6 +511,10 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 				interceptors = append(interceptors, dataSourceTransparentTagging(v.Tags))
 			}
 
+			if telemetry.IsEnabled() {
+				interceptors = append(interceptors, dataSourceTelemetry(typeName))
+			}
+
 			opts := wrappedDataSourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
@@ -490,6 +575,10 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 					interceptors = append(interceptors, ephemeralResourceSetRegionInResult())
 				}
 
+				if telemetry.IsEnabled() {
+					interceptors = append(interceptors, ephemeralResourceTelemetry(typeName))
+				}
+
 				opts := wrappedEphemeralResourceOptions{
 					// bootstrapContext is run on all wrapped methods before any interceptors.
 					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
@@ -523,6 +612,145 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 			}
 		}
 
+		if v, ok := sp.(conns.ServicePackageWithActions); ok {
+			for _, v := range v.Actions(ctx) {
+				typeName := v.TypeName
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					errs = append(errs, fmt.Errorf("creating action (%s): %w", typeName, err))
+					continue
+				}
+
+				var isRegionOverrideEnabled bool
+				if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+					isRegionOverrideEnabled = true
+				}
+
+				var interceptors interceptorInvocations
+
+				if isRegionOverrideEnabled {
+					v := v.Region.Value()
+
+					interceptors = append(interceptors, actionInjectRegionAttribute())
+					if v.IsValidateOverrideInPartition {
+						interceptors = append(interceptors, actionValidateRegion())
+					}
+				}
+
+				if telemetry.IsEnabled() {
+					interceptors = append(interceptors, actionTelemetry(typeName))
+				}
+
+				opts := wrappedActionOptions{
+					// bootstrapContext is run on all wrapped methods before any interceptors.
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+						var diags diag.Diagnostics
+						var overrideRegion string
+
+						if isRegionOverrideEnabled && getAttribute != nil {
+							var target types.String
+							diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+							if diags.HasError() {
+								return ctx, diags
+							}
+
+							overrideRegion = target.ValueString()
+						}
+
+						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+						if c != nil {
+							ctx = c.RegisterLogger(ctx)
+							ctx = fwflex.RegisterLogger(ctx)
+						}
+						return ctx, diags
+					},
+					interceptors: interceptors,
+					typeName:     v.TypeName,
+				}
+				p.actions = append(p.actions, func() action.Action {
+					return newWrappedAction(inner, opts)
+				})
+			}
+		}
+
+		if v, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			sdkResources := make(map[string]*inttypes.ServicePackageSDKResource)
+			for _, v := range sp.SDKResources(ctx) {
+				sdkResources[v.TypeName] = v
+			}
+
+			for _, v := range v.SDKListResources(ctx) {
+				typeName := v.TypeName
+				res, ok := sdkResources[typeName]
+				if !ok {
+					errs = append(errs, fmt.Errorf("list resource type %s: no matching SDK resource", typeName))
+					continue
+				}
+				if len(res.Identity.Attributes) == 0 {
+					errs = append(errs, fmt.Errorf("list resource type %s: resource has no identity", typeName))
+					continue
+				}
+
+				// The SDK resource as wrapped and served by the primary provider.
+				var r *sdkschema.Resource
+				if primary, ok := p.primary.(*sdkschema.Provider); ok {
+					r = primary.ResourcesMap[typeName]
+				}
+				if r == nil {
+					errs = append(errs, fmt.Errorf("list resource type %s: SDK resource not found in primary provider", typeName))
+					continue
+				}
+
+				if _, ok := v.Factory().(framework.ListResourceWithSDKv2Resourcer); !ok {
+					errs = append(errs, fmt.Errorf("list resource type %s: does not list SDK resources", typeName))
+					continue
+				}
+
+				var isRegionOverrideEnabled, isValidateOverrideInPartition bool
+				if v := res.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+					isRegionOverrideEnabled = true
+					isValidateOverrideInPartition = v.Value().IsValidateOverrideInPartition
+				}
+
+				opts := wrappedListResourceOptions{
+					// bootstrapContext is run on all wrapped methods.
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+						var diags diag.Diagnostics
+						var overrideRegion string
+
+						if isRegionOverrideEnabled && getAttribute != nil {
+							var target types.String
+							diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+							if diags.HasError() {
+								return ctx, diags
+							}
+
+							overrideRegion = target.ValueString()
+						}
+
+						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+						if c != nil {
+							ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+							ctx = c.RegisterLogger(ctx)
+							ctx = fwflex.RegisterLogger(ctx)
+						}
+
+						return ctx, diags
+					},
+					injectRegionAttribute: isRegionOverrideEnabled,
+					validateRegion:        isValidateOverrideInPartition,
+					typeName:              typeName,
+				}
+				p.listResources = append(p.listResources, func() list.ListResource {
+					inner := v.Factory()
+					inner.(framework.ListResourceWithSDKv2Resourcer).SetSDKv2Resource(r, res.Identity)
+
+					return newWrappedListResource(inner, opts)
+				})
+			}
+		}
+
 		for _, res := range sp.FrameworkResources(ctx) {
 			typeName := res.TypeName
 			inner, err := res.Factory(ctx)
@@ -610,6 +838,10 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 				opts.interceptors = append(opts.interceptors, newIdentityInterceptor(res.Identity.Attributes))
 			}
 
+			if telemetry.IsEnabled() {
+				opts.interceptors = append(opts.interceptors, resourceTelemetry(typeName))
+			}
+
 			p.resources = append(p.resources, func() resource.Resource {
 				return newWrappedResource(inner, opts)
 			})
@@ -658,6 +890,28 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 			}
 		}
 
+		if v, ok := sp.(conns.ServicePackageWithActions); ok {
+			for _, v := range v.Actions(ctx) {
+				typeName := v.TypeName
+				a, err := v.Factory(ctx)
+
+				if err != nil {
+					errs = append(errs, fmt.Errorf("creating action (%s): %w", typeName, err))
+					continue
+				}
+
+				schemaResponse := action.SchemaResponse{}
+				a.Schema(ctx, action.SchemaRequest{}, &schemaResponse)
+
+				if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s action", names.AttrRegion, typeName))
+						continue
+					}
+				}
+			}
+		}
+
 		if v, ok := sp.(conns.ServicePackageWithEphemeralResources); ok {
 			for _, v := range v.EphemeralResources(ctx) {
 				typeName := v.TypeName
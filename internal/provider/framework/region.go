@@ -7,6 +7,8 @@ import (
 	"context"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	aschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -30,6 +32,56 @@ func validateInContextRegionInPartition(ctx context.Context, c *conns.AWSClient)
 	return diags
 }
 
+type actionInjectRegionAttributeInterceptor struct{}
+
+func (r actionInjectRegionAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[action.SchemaRequest, action.SchemaResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]aschema.Attribute)
+		}
+		if _, ok := response.Schema.Attributes[names.AttrRegion]; !ok {
+			// Inject a top-level "region" attribute.
+			response.Schema.Attributes[names.AttrRegion] = aschema.StringAttribute{
+				Optional:    true,
+				Description: names.TopLevelRegionAttributeDescription,
+			}
+		}
+	}
+
+	return diags
+}
+
+// actionInjectRegionAttribute injects a top-level "region" attribute into an action's schema.
+func actionInjectRegionAttribute() actionSchemaInterceptor {
+	return &actionInjectRegionAttributeInterceptor{}
+}
+
+type actionValidateRegionInterceptor struct{}
+
+func (r actionValidateRegionInterceptor) invoke(ctx context.Context, opts interceptorOptions[action.InvokeRequest, action.InvokeResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch when := opts.when; when {
+	case Before:
+		// As actions are run at apply time we validate the per-action Region override value here.
+		diags.Append(validateInContextRegionInPartition(ctx, c)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// actionValidateRegion validates that the value of the top-level `region` attribute is in the configured AWS partition.
+func actionValidateRegion() actionInvokeInterceptor {
+	return &actionValidateRegionInterceptor{}
+}
+
 type dataSourceInjectRegionAttributeInterceptor struct{}
 
 func (r dataSourceInjectRegionAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[datasource.SchemaRequest, datasource.SchemaResponse]) diag.Diagnostics {
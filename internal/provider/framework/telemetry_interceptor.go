@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/telemetry"
+)
+
+// telemetryInterceptor records operation latencies for the telemetry summary.
+// It should be the last interceptor in the chain so that only the time spent in the handler is measured.
+type telemetryInterceptor struct {
+	kind     string
+	typeName string
+}
+
+func (r telemetryInterceptor) record(ctx context.Context, when when, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch key := conns.TelemetryKey(ctx); when {
+	case Before:
+		telemetry.StartOperation(key, r.kind, r.typeName, operation)
+	case After:
+		telemetry.FinishOperation(key, false)
+	case OnError:
+		telemetry.FinishOperation(key, true)
+	}
+
+	return diags
+}
+
+type actionTelemetryInterceptor struct {
+	telemetryInterceptor
+}
+
+func (r actionTelemetryInterceptor) invoke(ctx context.Context, opts interceptorOptions[action.InvokeRequest, action.InvokeResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "Invoke")
+}
+
+func actionTelemetry(typeName string) actionInvokeInterceptor {
+	return &actionTelemetryInterceptor{
+		telemetryInterceptor: telemetryInterceptor{kind: telemetry.KindAction, typeName: typeName},
+	}
+}
+
+type dataSourceTelemetryInterceptor struct {
+	telemetryInterceptor
+}
+
+func (r dataSourceTelemetryInterceptor) read(ctx context.Context, opts interceptorOptions[datasource.ReadRequest, datasource.ReadResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "Read")
+}
+
+func dataSourceTelemetry(typeName string) dataSourceCRUDInterceptor {
+	return &dataSourceTelemetryInterceptor{
+		telemetryInterceptor: telemetryInterceptor{kind: telemetry.KindDataSource, typeName: typeName},
+	}
+}
+
+type ephemeralResourceTelemetryInterceptor struct {
+	telemetryInterceptor
+}
+
+func (r ephemeralResourceTelemetryInterceptor) open(ctx context.Context, opts interceptorOptions[ephemeral.OpenRequest, ephemeral.OpenResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "Open")
+}
+
+func (r ephemeralResourceTelemetryInterceptor) renew(ctx context.Context, opts interceptorOptions[ephemeral.RenewRequest, ephemeral.RenewResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "Renew")
+}
+
+func (r ephemeralResourceTelemetryInterceptor) close(ctx context.Context, opts interceptorOptions[ephemeral.CloseRequest, ephemeral.CloseResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "Close")
+}
+
+func ephemeralResourceTelemetry(typeName string) ephemeralResourceORCInterceptor {
+	return &ephemeralResourceTelemetryInterceptor{
+		telemetryInterceptor: telemetryInterceptor{kind: telemetry.KindEphemeralResource, typeName: typeName},
+	}
+}
+
+type resourceTelemetryInterceptor struct {
+	telemetryInterceptor
+}
+
+func (r resourceTelemetryInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "Create")
+}
+
+func (r resourceTelemetryInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "Read")
+}
+
+func (r resourceTelemetryInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "Update")
+}
+
+func (r resourceTelemetryInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "Delete")
+}
+
+func (r resourceTelemetryInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "ModifyPlan")
+}
+
+func (r resourceTelemetryInterceptor) importState(ctx context.Context, opts interceptorOptions[resource.ImportStateRequest, resource.ImportStateResponse]) diag.Diagnostics {
+	return r.record(ctx, opts.when, "ImportState")
+}
+
+func resourceTelemetry(typeName string) resourceCRUDInterceptor {
+	return &resourceTelemetryInterceptor{
+		telemetryInterceptor: telemetryInterceptor{kind: telemetry.KindResource, typeName: typeName},
+	}
+}
@@ -31,7 +31,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/telemetry"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -270,6 +272,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:    true,
 					Description: "Resolve an endpoint with FIPS capability",
 				},
+				"vcr": vcrSchema(),
 			},
 
 			// Data sources and resources implemented using Terraform Plugin SDK
@@ -485,6 +488,21 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		}
 	}
 
+	if v, ok := d.GetOk("vcr"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		vcrConfig, dg := expandVCR(ctx, cty.GetAttrPath("vcr").IndexInt(0), v.([]any)[0].(map[string]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.VCR = vcrConfig
+	} else {
+		vcrConfig, err := vcr.ConfigFromEnvironment()
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.VCR = vcrConfig
+	}
+
 	var c *conns.AWSClient
 	if v, ok := p.provider.Meta().(*conns.AWSClient); ok {
 		c = v
@@ -576,6 +594,10 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			if telemetry.IsEnabled() {
+				interceptors = append(interceptors, dataSourceTelemetry(typeName))
+			}
+
 			opts := wrappedDataSourceOptions{
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
 					var overrideRegion string
@@ -715,6 +737,10 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				interceptors = append(interceptors, newIdentityInterceptor(resource.Identity.Attributes))
 			}
 
+			if telemetry.IsEnabled() {
+				interceptors = append(interceptors, resourceTelemetry(typeName)...)
+			}
+
 			if resource.Import.WrappedImport {
 				if r.Importer != nil && r.Importer.StateContext != nil {
 					errs = append(errs, fmt.Errorf("resource type %s: uses WrappedImport but defines an import function", typeName))
@@ -903,6 +929,42 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func vcrSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to record AWS API calls to, or replay them from, a cassette file.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cassette_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the cassette file, without extension. Defaults to `" + vcr.DefaultCassetteName + "`.",
+				},
+				"mode": {
+					Type:     schema.TypeString,
+					Required: true,
+					Description: "Recording mode. Valid values are `RECORD_ONLY` and `REPLAY_ONLY`. " +
+						"Can also be configured using the `VCR_MODE` environment variable.",
+				},
+				"path": {
+					Type:     schema.TypeString,
+					Required: true,
+					Description: "Directory in which the cassette file is stored. " +
+						"Can also be configured using the `VCR_PATH` environment variable.",
+				},
+				"redact": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Whether to redact AWS account IDs and secret values from recorded API calls. " +
+						"Can also be configured using the `VCR_REDACT` environment variable.",
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -1090,6 +1152,22 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	return nil
 }
 
+func expandVCR(_ context.Context, path cty.Path, tfMap map[string]any) (*vcr.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	mode, err := vcr.ParseMode(tfMap["mode"].(string))
+	if err != nil {
+		return nil, append(diags, errs.NewInvalidValueAttributeError(path.GetAttr("mode"), err.Error()))
+	}
+
+	return &vcr.Config{
+		CassetteName: tfMap["cassette_name"].(string),
+		Mode:         mode,
+		Path:         tfMap["path"].(string),
+		Redact:       tfMap["redact"].(bool),
+	}, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/telemetry"
+)
+
+// dataSourceTelemetry returns the interceptor that records data source operation latencies for the telemetry summary.
+// It should be the last interceptor in the chain so that only the time spent in the handler is measured.
+func dataSourceTelemetry(typeName string) interceptorInvocation {
+	return interceptorInvocation{
+		when:        Before | After | OnError,
+		why:         Read,
+		interceptor: crudTelemetry(telemetry.KindDataSource, typeName),
+	}
+}
+
+// resourceTelemetry returns the interceptors that record resource operation latencies for the telemetry summary.
+// They should be the last interceptors in the chain so that only the time spent in the handlers is measured.
+func resourceTelemetry(typeName string) []interceptorInvocation {
+	return []interceptorInvocation{
+		{
+			when:        Before | After | OnError,
+			why:         AllCRUDOps,
+			interceptor: crudTelemetry(telemetry.KindResource, typeName),
+		},
+		{
+			when:        Before | After | OnError,
+			why:         CustomizeDiff,
+			interceptor: customizeDiffTelemetry(typeName),
+		},
+		{
+			when:        Before | After | OnError,
+			why:         Import,
+			interceptor: importTelemetry(typeName),
+		},
+	}
+}
+
+func crudTelemetry(kind, typeName string) crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		recordTelemetry(ctx, kind, typeName, opts.when, opts.why)
+
+		return diags
+	})
+}
+
+func customizeDiffTelemetry(typeName string) customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		recordTelemetry(ctx, telemetry.KindResource, typeName, opts.when, opts.why)
+
+		return nil
+	})
+}
+
+func importTelemetry(typeName string) importInterceptor {
+	return interceptorFunc2[*schema.ResourceData, []*schema.ResourceData, error](func(ctx context.Context, opts importInterceptorOptions) ([]*schema.ResourceData, error) {
+		recordTelemetry(ctx, telemetry.KindResource, typeName, opts.when, opts.why)
+
+		return nil, nil
+	})
+}
+
+func recordTelemetry(ctx context.Context, kind, typeName string, when when, why why) {
+	switch key := conns.TelemetryKey(ctx); when {
+	case Before:
+		telemetry.StartOperation(key, kind, typeName, telemetryOperation(why))
+	case After:
+		telemetry.FinishOperation(key, false)
+	case OnError:
+		telemetry.FinishOperation(key, true)
+	}
+}
+
+func telemetryOperation(why why) string {
+	switch why {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	case CustomizeDiff:
+		return "CustomizeDiff"
+	case Import:
+		return "Import"
+	default:
+		return ""
+	}
+}
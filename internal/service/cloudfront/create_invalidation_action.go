@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_cloudfront_create_invalidation", name="Create Invalidation")
+func newCreateInvalidationAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createInvalidationAction{}, nil
+}
+
+const (
+	createInvalidationDefaultTimeout = 15 * time.Minute
+)
+
+type createInvalidationAction struct {
+	framework.ActionWithModel[createInvalidationActionModel]
+}
+
+func (a *createInvalidationAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Invalidates files in a CloudFront distribution's edge caches and waits for the invalidation to complete.",
+		Attributes: map[string]schema.Attribute{
+			"caller_reference": schema.StringAttribute{
+				Optional:    true,
+				Description: "Unique value that ensures the request can't be replayed. Defaults to a generated value.",
+			},
+			"distribution_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the distribution to invalidate.",
+			},
+			"paths": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Paths to invalidate, for example `/*` or `/images/*`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time, in seconds, to wait for the invalidation to complete. Defaults to 900.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *createInvalidationAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data createInvalidationActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CloudFrontClient(ctx)
+
+	distributionID := fwflex.StringValueFromFramework(ctx, data.DistributionID)
+	callerReference := fwflex.StringValueFromFramework(ctx, data.CallerReference)
+	if callerReference == "" {
+		callerReference = sdkid.UniqueId()
+	}
+	paths := fwflex.ExpandFrameworkStringValueList(ctx, data.Paths)
+	timeout := createInvalidationDefaultTimeout
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	input := cloudfront.CreateInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		InvalidationBatch: &awstypes.InvalidationBatch{
+			CallerReference: aws.String(callerReference),
+			Paths: &awstypes.Paths{
+				Items:    paths,
+				Quantity: aws.Int32(int32(len(paths))),
+			},
+		},
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Creating CloudFront Distribution (%s) invalidation", distributionID),
+	})
+
+	output, err := conn.CreateInvalidation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront Distribution (%s) invalidation", distributionID), err.Error())
+		return
+	}
+
+	id := aws.ToString(output.Invalidation.Id)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for CloudFront Distribution (%s) invalidation (%s) to complete", distributionID, id),
+	})
+
+	if _, err := waitInvalidationCompleted(ctx, conn, distributionID, id, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution (%s) invalidation (%s) complete", distributionID, id), err.Error())
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CloudFront Distribution (%s) invalidation (%s) completed", distributionID, id),
+	})
+}
+
+type createInvalidationActionModel struct {
+	CallerReference types.String         `tfsdk:"caller_reference"`
+	DistributionID  types.String         `tfsdk:"distribution_id"`
+	Paths           fwtypes.ListOfString `tfsdk:"paths"`
+	Timeout         types.Int64          `tfsdk:"timeout"`
+}
+
+func findInvalidationByTwoPartKey(ctx context.Context, conn *cloudfront.Client, distributionID, id string) (*awstypes.Invalidation, error) {
+	input := cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		Id:             aws.String(id),
+	}
+
+	output, err := conn.GetInvalidation(ctx, &input)
+
+	if errs.IsA[*awstypes.NoSuchDistribution](err) || errs.IsA[*awstypes.NoSuchInvalidation](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Invalidation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Invalidation, nil
+}
+
+func statusInvalidation(ctx context.Context, conn *cloudfront.Client, distributionID, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findInvalidationByTwoPartKey(ctx, conn, distributionID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitInvalidationCompleted(ctx context.Context, conn *cloudfront.Client, distributionID, id string, timeout time.Duration) (*awstypes.Invalidation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{invalidationStatusInProgress},
+		Target:     []string{invalidationStatusCompleted},
+		Refresh:    statusInvalidation(ctx, conn, distributionID, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Invalidation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
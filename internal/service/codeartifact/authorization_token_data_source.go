@@ -6,85 +6,119 @@ package codeartifact
 import (
 	"context"
 	"fmt"
-	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKDataSource("aws_codeartifact_authorization_token", name="Authoiration Token")
-func dataSourceAuthorizationToken() *schema.Resource {
-	return &schema.Resource{
-		ReadWithoutTimeout: dataSourceAuthorizationTokenRead,
+// @FrameworkDataSource("aws_codeartifact_authorization_token", name="Authorization Token")
+func newAuthorizationTokenDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &authorizationTokenDataSource{}, nil
+}
+
+type authorizationTokenDataSource struct {
+	framework.DataSourceWithModel[authorizationTokenDataSourceModel]
+}
 
-		Schema: map[string]*schema.Schema{
-			"authorization_token": {
-				Type:     schema.TypeString,
+func (d *authorizationTokenDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
 				Computed: true,
 			},
-			names.AttrDomain: {
-				Type:     schema.TypeString,
+			names.AttrDomain: schema.StringAttribute{
 				Required: true,
 			},
-			"domain_owner": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: verify.ValidAccountID,
+			"domain_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
 			},
-			"duration_seconds": {
-				Type:     schema.TypeInt,
+			"duration_seconds": schema.Int64Attribute{
 				Optional: true,
-				ValidateFunc: validation.Any(
-					validation.IntBetween(900, 43200),
-					validation.IntInSlice([]int{0}),
-				),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.Between(900, 43200),
+						int64validator.OneOf(0),
+					),
+				},
 			},
-			"expiration": {
-				Type:     schema.TypeString,
-				Computed: true,
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
 			},
+			names.AttrID: framework.IDAttribute(),
 		},
 	}
 }
 
-func dataSourceAuthorizationTokenRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+func (d *authorizationTokenDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data authorizationTokenDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := getAuthorizationToken(ctx, d.Meta(), &data.authorizationTokenModel); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CodeArtifact Authorization Token (%s)", data.Domain.ValueString()), err.Error())
 
-	domainName := d.Get(names.AttrDomain).(string)
-	var domainOwner string
-	if v, ok := d.GetOk("domain_owner"); ok {
-		domainOwner = v.(string)
-	} else {
-		domainOwner = meta.(*conns.AWSClient).AccountID(ctx)
+		return
 	}
-	input := &codeartifact.GetAuthorizationTokenInput{
-		Domain:      aws.String(domainName),
-		DomainOwner: aws.String(domainOwner),
+
+	data.ID = fwflex.StringValueToFramework(ctx, fmt.Sprintf("%s:%s", data.DomainOwner.ValueString(), data.Domain.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// authorizationTokenModel is shared by the authorization token data source and ephemeral resource.
+type authorizationTokenModel struct {
+	framework.WithRegionModel
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	Domain             types.String      `tfsdk:"domain"`
+	DomainOwner        types.String      `tfsdk:"domain_owner"`
+	DurationSeconds    types.Int64       `tfsdk:"duration_seconds"`
+	Expiration         timetypes.RFC3339 `tfsdk:"expiration"`
+}
+
+type authorizationTokenDataSourceModel struct {
+	authorizationTokenModel
+	ID types.String `tfsdk:"id"`
+}
+
+// getAuthorizationToken requests an authorization token for the model's domain and sets the model's computed attributes.
+func getAuthorizationToken(ctx context.Context, c *conns.AWSClient, data *authorizationTokenModel) error {
+	conn := c.CodeArtifactClient(ctx)
+
+	if data.DomainOwner.IsNull() || data.DomainOwner.IsUnknown() {
+		data.DomainOwner = fwflex.StringValueToFramework(ctx, c.AccountID(ctx))
 	}
 
-	if v, ok := d.GetOkExists("duration_seconds"); ok {
-		input.DurationSeconds = aws.Int64(int64(v.(int)))
+	input := codeartifact.GetAuthorizationTokenInput{
+		Domain:          fwflex.StringFromFramework(ctx, data.Domain),
+		DomainOwner:     fwflex.StringFromFramework(ctx, data.DomainOwner),
+		DurationSeconds: fwflex.Int64FromFramework(ctx, data.DurationSeconds),
 	}
 
-	output, err := conn.GetAuthorizationToken(ctx, input)
+	output, err := conn.GetAuthorizationToken(ctx, &input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Authorization Token: %s", err)
+		return err
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", domainOwner, domainName))
-	d.Set("authorization_token", output.AuthorizationToken)
-	d.Set("domain_owner", domainOwner)
-	d.Set("expiration", aws.ToTime(output.Expiration).Format(time.RFC3339))
+	data.AuthorizationToken = fwflex.StringToFramework(ctx, output.AuthorizationToken)
+	data.Expiration = fwflex.TimeToFramework(ctx, output.Expiration)
 
-	return diags
+	return nil
 }
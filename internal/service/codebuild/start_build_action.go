@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codebuild
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_codebuild_start_build", name="Start Build")
+func newStartBuildAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startBuildAction{}, nil
+}
+
+const (
+	startBuildDefaultTimeout = 30 * time.Minute
+)
+
+type startBuildAction struct {
+	framework.ActionWithModel[startBuildActionModel]
+}
+
+func (a *startBuildAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Starts a CodeBuild project build and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"project_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the project to build.",
+			},
+			"source_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version of the source to build, for example a commit ID, branch or tag. Defaults to the project's source version.",
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time, in seconds, to wait for the build to complete. Defaults to 1800.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"environment_variables_override": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[environmentVariableModel](ctx),
+				Description: "Environment variables that override, for this build only, those defined in the project.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.EnvironmentVariableType](),
+							Optional:    true,
+							Description: "Type of the environment variable. Defaults to `PLAINTEXT`.",
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startBuildAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data startBuildActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CodeBuildClient(ctx)
+
+	projectName := fwflex.StringValueFromFramework(ctx, data.ProjectName)
+	timeout := startBuildDefaultTimeout
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	var input codebuild.StartBuildInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting CodeBuild Project (%s) build", projectName),
+	})
+
+	output, err := conn.StartBuild(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting CodeBuild Project (%s) build", projectName), err.Error())
+		return
+	}
+
+	id := aws.ToString(output.Build.Id)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for CodeBuild Build (%s) to complete", id),
+	})
+
+	if _, err := waitBuildSucceeded(ctx, conn, id, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CodeBuild Build (%s) complete", id), err.Error())
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CodeBuild Build (%s) succeeded", id),
+	})
+}
+
+type startBuildActionModel struct {
+	framework.WithRegionModel
+	EnvironmentVariablesOverride fwtypes.ListNestedObjectValueOf[environmentVariableModel] `tfsdk:"environment_variables_override"`
+	ProjectName                  types.String                                              `tfsdk:"project_name"`
+	SourceVersion                types.String                                              `tfsdk:"source_version"`
+	Timeout                      types.Int64                                               `tfsdk:"timeout"`
+}
+
+type environmentVariableModel struct {
+	Name  types.String                                         `tfsdk:"name"`
+	Type  fwtypes.StringEnum[awstypes.EnvironmentVariableType] `tfsdk:"type"`
+	Value types.String                                         `tfsdk:"value"`
+}
+
+func findBuildByID(ctx context.Context, conn *codebuild.Client, id string) (*awstypes.Build, error) {
+	input := &codebuild.BatchGetBuildsInput{
+		Ids: []string{id},
+	}
+
+	return findBuild(ctx, conn, input)
+}
+
+func findBuild(ctx context.Context, conn *codebuild.Client, input *codebuild.BatchGetBuildsInput) (*awstypes.Build, error) {
+	output, err := findBuilds(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findBuilds(ctx context.Context, conn *codebuild.Client, input *codebuild.BatchGetBuildsInput) ([]awstypes.Build, error) {
+	output, err := conn.BatchGetBuilds(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Builds, nil
+}
+
+func statusBuild(ctx context.Context, conn *codebuild.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findBuildByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitBuildSucceeded(ctx context.Context, conn *codebuild.Client, id string, timeout time.Duration) (*awstypes.Build, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusTypeInProgress),
+		Target:     enum.Slice(awstypes.StatusTypeSucceeded),
+		Refresh:    statusBuild(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Build); ok {
+		return output, err
+	}
+
+	return nil, err
+}
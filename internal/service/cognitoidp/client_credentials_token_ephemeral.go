@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_cognito_client_credentials_token, name="Client Credentials Token")
+func newClientCredentialsTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &clientCredentialsTokenEphemeralResource{}, nil
+}
+
+type clientCredentialsTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[clientCredentialsTokenEphemeralResourceModel]
+}
+
+func (e *clientCredentialsTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrClientID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrClientSecret: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			names.AttrDomain: schema.StringAttribute{
+				Optional: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Computed: true,
+			},
+			"scopes": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+			},
+			"token_type": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUserPoolID: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *clientCredentialsTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data clientCredentialsTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().CognitoIDPClient(ctx)
+
+	userPoolID, clientID := data.UserPoolID.ValueString(), data.ClientID.ValueString()
+
+	domain := data.Domain.ValueString()
+	if domain == "" {
+		userPool, err := findUserPoolByID(ctx, conn, userPoolID)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Cognito User Pool (%s)", userPoolID), err.Error())
+
+			return
+		}
+
+		domain = aws.ToString(userPool.CustomDomain)
+		if domain == "" {
+			domain = aws.ToString(userPool.Domain)
+		}
+
+		if domain == "" {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Cognito User Pool (%s)", userPoolID), "user pool has no domain")
+
+			return
+		}
+	}
+
+	clientSecret := data.ClientSecret.ValueString()
+	if clientSecret == "" {
+		userPoolClient, err := findUserPoolClientByTwoPartKey(ctx, conn, userPoolID, clientID)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Cognito User Pool Client (%s)", clientID), err.Error())
+
+			return
+		}
+
+		clientSecret = aws.ToString(userPoolClient.ClientSecret)
+
+		if clientSecret == "" {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Cognito User Pool Client (%s)", clientID), "the client credentials grant requires a user pool client with a client secret")
+
+			return
+		}
+	}
+
+	tokenURL := userPoolDomainTokenURL(domain, e.Meta().Region(ctx))
+	issuedAt := time.Now()
+	output, err := requestClientCredentialsToken(ctx, e.Meta().AwsConfig(ctx).HTTPClient, tokenURL, clientID, clientSecret, fwflex.ExpandFrameworkStringValueSet(ctx, data.Scopes))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("requesting Cognito client credentials token (%s)", clientID), err.Error())
+
+		return
+	}
+
+	expiration := issuedAt.Add(time.Duration(output.ExpiresIn) * time.Second)
+	data.AccessToken = fwflex.StringValueToFramework(ctx, output.AccessToken)
+	data.Expiration = timetypes.NewRFC3339TimeValue(expiration)
+	data.ExpiresIn = types.Int64Value(output.ExpiresIn)
+	data.TokenType = fwflex.StringValueToFramework(ctx, output.TokenType)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type clientCredentialsTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AccessToken  types.String        `tfsdk:"access_token"`
+	ClientID     types.String        `tfsdk:"client_id"`
+	ClientSecret types.String        `tfsdk:"client_secret"`
+	Domain       types.String        `tfsdk:"domain"`
+	Expiration   timetypes.RFC3339   `tfsdk:"expiration"`
+	ExpiresIn    types.Int64         `tfsdk:"expires_in"`
+	Scopes       fwtypes.SetOfString `tfsdk:"scopes"`
+	TokenType    types.String        `tfsdk:"token_type"`
+	UserPoolID   types.String        `tfsdk:"user_pool_id"`
+}
+
+// userPoolDomainTokenURL returns the OAuth 2.0 token endpoint for the specified user pool domain.
+// As with aws_cognito_user_pool_domain, a domain containing a dot is a custom domain and anything else is an Amazon Cognito domain prefix.
+func userPoolDomainTokenURL(domain, region string) string {
+	host := domain
+	if !strings.Contains(domain, ".") {
+		host = fmt.Sprintf("%s.auth.%s.amazoncognito.com", domain, region)
+	}
+
+	return (&url.URL{
+		Scheme: "https",
+		Host:   host,
+		Path:   "/oauth2/token",
+	}).String()
+}
+
+type clientCredentialsToken struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+type clientCredentialsTokenError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// requestClientCredentialsToken performs the OAuth 2.0 client credentials grant against the specified token endpoint.
+// See https://docs.aws.amazon.com/cognito/latest/developerguide/token-endpoint.html.
+func requestClientCredentialsToken(ctx context.Context, client aws.HTTPClient, tokenURL, clientID, clientSecret string, scopes []string) (*clientCredentialsToken, error) {
+	form := url.Values{
+		"grant_type": []string{"client_credentials"},
+	}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.SetBasicAuth(clientID, clientSecret)
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		var apiErr clientCredentialsTokenError
+		if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Error != "" {
+			if apiErr.ErrorDescription != "" {
+				return nil, fmt.Errorf("%s: %s (%s)", response.Status, apiErr.Error, apiErr.ErrorDescription)
+			}
+
+			return nil, fmt.Errorf("%s: %s", response.Status, apiErr.Error)
+		}
+
+		return nil, errors.New(response.Status)
+	}
+
+	var token clientCredentialsToken
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("decoding token response: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return nil, errors.New("token response contains no access token")
+	}
+
+	return &token, nil
+}
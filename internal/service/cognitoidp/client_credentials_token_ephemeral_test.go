@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcognitoidp "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestUserPoolDomainTokenURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		domain   string
+		region   string
+		expected string
+	}{
+		{
+			testName: "prefix domain",
+			domain:   "example",
+			region:   "us-west-2",
+			expected: "https://example.auth.us-west-2.amazoncognito.com/oauth2/token",
+		},
+		{
+			testName: "custom domain",
+			domain:   "auth.example.com",
+			region:   "us-west-2",
+			expected: "https://auth.example.com/oauth2/token",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfcognitoidp.UserPoolDomainTokenURL(testCase.domain, testCase.region), testCase.expected; got != want {
+				t.Errorf("UserPoolDomainTokenURL(%q, %q) = %q, want %q", testCase.domain, testCase.region, got, want)
+			}
+		})
+	}
+}
+
+func TestAccCognitoIDPClientCredentialsTokenEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientCredentialsTokenEphemeralResourceConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_in"), knownvalue.Int64Exact(3600)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token_type"), knownvalue.StringExact("Bearer")),
+				},
+			},
+		},
+	})
+}
+
+func testAccClientCredentialsTokenEphemeralResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cognito_client_credentials_token.test"),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_resource_server" "test" {
+  identifier   = "https://example.com"
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  scope {
+    scope_name        = "read"
+    scope_description = "Read access"
+  }
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name            = %[1]q
+  user_pool_id    = aws_cognito_user_pool.test.id
+  generate_secret = true
+
+  allowed_oauth_flows                  = ["client_credentials"]
+  allowed_oauth_flows_user_pool_client = true
+  allowed_oauth_scopes                 = aws_cognito_resource_server.test.scope_identifiers
+}
+
+ephemeral "aws_cognito_client_credentials_token" "test" {
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+  client_id    = aws_cognito_user_pool_client.test.id
+  scopes       = aws_cognito_user_pool_client.test.allowed_oauth_scopes
+}
+`, rName))
+}
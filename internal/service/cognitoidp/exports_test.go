@@ -28,4 +28,5 @@ var (
 	FindUserPoolClientByTwoPartKey          = findUserPoolClientByTwoPartKey
 	FindUserPoolDomain                      = findUserPoolDomain
 	FindUserPoolUICustomizationByTwoPartKey = findUserPoolUICustomizationByTwoPartKey
+	UserPoolDomainTokenURL                  = userPoolDomainTokenURL
 )
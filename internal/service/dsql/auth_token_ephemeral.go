@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dsql/auth"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthToken = "Ephemeral Resource Auth Token"
+)
+
+// @EphemeralResource(aws_dsql_auth_token, name="Auth Token")
+func newAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin": schema.BoolAttribute{
+				Optional: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 604800),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	generateAuthToken := auth.GenerateDbConnectAuthToken
+	if data.Admin.ValueBool() {
+		generateAuthToken = auth.GenerateDBConnectAdminAuthToken
+	}
+
+	// The token's validity is limited to the lifetime of any temporary credentials. The default is 15 minutes.
+	optFns := []func(*auth.TokenOptions){}
+	if !data.ExpiresIn.IsNull() {
+		optFns = append(optFns, func(o *auth.TokenOptions) {
+			o.ExpiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+		})
+	}
+
+	hostname := data.Hostname.ValueString()
+	token, err := generateAuthToken(ctx, hostname, e.Meta().Region(ctx), e.Meta().CredentialsProvider(ctx), optFns...)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DSQL, create.ErrActionReading, ERNameAuthToken, hostname, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Token = fwflex.StringValueToFramework(ctx, token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Admin     types.Bool   `tfsdk:"admin"`
+	ExpiresIn types.Int64  `tfsdk:"expires_in"`
+	Hostname  types.String `tfsdk:"hostname"`
+	Token     types.String `tfsdk:"token"`
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_instance", name="Instance")
+func newInstanceListResource() list.ListResourceWithConfigure {
+	return &instanceListResource{}
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query instanceListResourceModel
+	if diags := request.Config.Get(ctx, &query); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeInstancesInput{
+		Filters: newCustomListFilterList(ctx, query.Filters),
+	}
+	// Terminated instances can't be managed, so exclude them unless the instance state is explicitly filtered on.
+	if !slices.ContainsFunc(input.Filters, func(v awstypes.Filter) bool {
+		return aws.ToString(v.Name) == "instance-state-name"
+	}) {
+		input.Filters = append(input.Filters, newFilter("instance-state-name", enum.Slice(
+			awstypes.InstanceStateNamePending,
+			awstypes.InstanceStateNameRunning,
+			awstypes.InstanceStateNameShuttingDown,
+			awstypes.InstanceStateNameStopping,
+			awstypes.InstanceStateNameStopped,
+		)))
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := ec2.NewDescribeInstancesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(framework.ListResultError("listing EC2 Instances", err))
+				return
+			}
+
+			for _, v := range page.Reservations {
+				for _, v := range v.Instances {
+					id := aws.ToString(v.InstanceId)
+					displayName := id
+					if v := keyValueTags(ctx, v.Tags).KeyValue("Name"); v != nil {
+						displayName = aws.ToString(v)
+					}
+
+					d := l.ResourceData()
+					d.SetId(id)
+
+					result, ok := l.NewListResult(ctx, request, d, displayName)
+					if !ok {
+						continue
+					}
+
+					if !yield(result) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+type instanceListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_ec2_stop_instance", name="Stop Instance")
+func newStopInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &stopInstanceAction{}, nil
+}
+
+const (
+	stopInstanceDefaultTimeout = 10 * time.Minute
+)
+
+type stopInstanceAction struct {
+	framework.ActionWithModel[stopInstanceActionModel]
+}
+
+func (a *stopInstanceAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Stops an EC2 instance and waits for it to reach the stopped state.",
+		Attributes: map[string]schema.Attribute{
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Forces the instance to stop without flushing file system caches or metadata. Defaults to `false`.",
+			},
+			names.AttrInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the instance to stop.",
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time, in seconds, to wait for the instance to stop. Defaults to 600.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+				},
+			},
+		},
+	}
+}
+
+func (a *stopInstanceAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data stopInstanceActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.InstanceID)
+	timeout := stopInstanceDefaultTimeout
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	instance, err := findInstanceByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Instance (%s)", id), err.Error())
+		return
+	}
+
+	if instance.State != nil && instance.State.Name == awstypes.InstanceStateNameStopped {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("EC2 Instance (%s) is already stopped", id),
+		})
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Stopping EC2 Instance (%s)", id),
+	})
+
+	if err := stopInstance(ctx, conn, id, data.Force.ValueBool(), timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping EC2 Instance (%s)", id), err.Error())
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("EC2 Instance (%s) stopped", id),
+	})
+}
+
+type stopInstanceActionModel struct {
+	framework.WithRegionModel
+	Force      types.Bool   `tfsdk:"force"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -169,6 +170,36 @@ type (
 	customFilters = fwtypes.SetNestedObjectValueOf[customFilterModel]
 )
 
+// customListFiltersBlock is the list resource variant of customFiltersSchema.
+// List resource configuration schemas do not support sets, so filters are modeled as lists.
+func customListFiltersBlock(ctx context.Context) listschema.Block {
+	return listschema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[customListFilterModel](ctx),
+		NestedObject: listschema.NestedBlockObject{
+			Attributes: map[string]listschema.Attribute{
+				names.AttrName: listschema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValues: listschema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// customListFilterModel represents a single configured list resource filter.
+type customListFilterModel struct {
+	Name   types.String         `tfsdk:"name"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+type (
+	customListFilters = fwtypes.ListNestedObjectValueOf[customListFilterModel]
+)
+
 // newCustomFilterList takes the set value extracted from a schema
 // attribute conforming to the schema returned by CustomFiltersSchema,
 // and transforms it into a []*ec2.Filter representing the same filter
@@ -218,6 +249,34 @@ func newCustomFilterListFramework(ctx context.Context, customFilters customFilte
 	return filters
 }
 
+// newCustomListFilterList is the list resource variant of newCustomFilterListFramework.
+func newCustomListFilterList(ctx context.Context, customFilters customListFilters) []awstypes.Filter {
+	if customFilters.IsNull() || customFilters.IsUnknown() {
+		return nil
+	}
+
+	var filters []awstypes.Filter
+
+	for _, v := range customFilters.Elements() {
+		var data customListFilterModel
+
+		if tfsdk.ValueAs(ctx, v, &data).HasError() {
+			continue
+		}
+
+		if data.Name.IsNull() || data.Name.IsUnknown() {
+			continue
+		}
+
+		filters = append(filters, awstypes.Filter{
+			Name:   fwflex.StringFromFramework(ctx, data.Name),
+			Values: fwflex.ExpandFrameworkStringValueList(ctx, data.Values),
+		})
+	}
+
+	return filters
+}
+
 // newAttributeFilterList takes a flat map of scalar attributes (most
 // likely values extracted from a *schema.ResourceData on an EC2-querying
 // data source) and produces a []*ec2.Filter representing an exact match
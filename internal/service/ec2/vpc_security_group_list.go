@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_security_group", name="Security Group")
+func newSecurityGroupListResource() list.ListResourceWithConfigure {
+	return &securityGroupListResource{}
+}
+
+type securityGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *securityGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query securityGroupListResourceModel
+	if diags := request.Config.Get(ctx, &query); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeSecurityGroupsInput{
+		Filters: newCustomListFilterList(ctx, query.Filters),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := ec2.NewDescribeSecurityGroupsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(framework.ListResultError("listing EC2 Security Groups", err))
+				return
+			}
+
+			for _, v := range page.SecurityGroups {
+				id := aws.ToString(v.GroupId)
+				displayName := aws.ToString(v.GroupName)
+				if v := keyValueTags(ctx, v.Tags).KeyValue("Name"); v != nil {
+					displayName = aws.ToString(v)
+				}
+
+				d := l.ResourceData()
+				d.SetId(id)
+
+				result, ok := l.NewListResult(ctx, request, d, displayName)
+				if !ok {
+					continue
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type securityGroupListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_ecs_force_new_deployment", name="Force New Deployment")
+func newForceNewDeploymentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &forceNewDeploymentAction{}, nil
+}
+
+const (
+	forceNewDeploymentDefaultTimeout = 20 * time.Minute
+)
+
+type forceNewDeploymentAction struct {
+	framework.ActionWithModel[forceNewDeploymentActionModel]
+}
+
+func (a *forceNewDeploymentAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Starts a new deployment of an ECS service, replacing its tasks without changing the service definition.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Required:    true,
+				Description: "Name or ARN of the cluster that hosts the service.",
+			},
+			"service": schema.StringAttribute{
+				Required:    true,
+				Description: "Name or ARN of the service to redeploy.",
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time, in seconds, to wait for the service to reach a steady state. Defaults to 1200.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"wait_for_steady_state": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the service to reach a steady state after the deployment starts. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (a *forceNewDeploymentAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data forceNewDeploymentActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := fwflex.StringValueFromFramework(ctx, data.Cluster)
+	service := fwflex.StringValueFromFramework(ctx, data.Service)
+	timeout := forceNewDeploymentDefaultTimeout
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: true,
+		Service:            aws.String(service),
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting new deployment of ECS Service (%s)", service),
+	})
+
+	_, err := conn.UpdateService(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating ECS Service (%s)", service), err.Error())
+		return
+	}
+
+	if !data.WaitForSteadyState.ValueBool() {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("New deployment of ECS Service (%s) started", service),
+		})
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for ECS Service (%s) to reach a steady state", service),
+	})
+
+	if _, err := waitServiceStable(ctx, conn, service, cluster, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ECS Service (%s) stable", service), err.Error())
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("ECS Service (%s) reached a steady state", service),
+	})
+}
+
+type forceNewDeploymentActionModel struct {
+	framework.WithRegionModel
+	Cluster            types.String `tfsdk:"cluster"`
+	Service            types.String `tfsdk:"service"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+	WaitForSteadyState types.Bool   `tfsdk:"wait_for_steady_state"`
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_kms_mac, name="MAC")
+func newMACEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &macEphemeralResource{}, nil
+}
+
+type macEphemeralResource struct {
+	framework.EphemeralResourceWithModel[macEphemeralResourceModel]
+}
+
+func (e *macEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"grant_tokens": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+			},
+			"mac": schema.StringAttribute{
+				Computed: true,
+			},
+			"mac_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MacAlgorithmSpec](),
+				Required:   true,
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *macEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data macEphemeralResourceModel
+	conn := e.Meta().KMSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := kms.GenerateMacInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithIgnoredFieldNamesAppend("Message"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	message, err := itypes.Base64Decode(data.Message.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrMessage), "invalid base64 value for message", err.Error())
+
+		return
+	}
+
+	input.Message = message
+
+	keyID := data.KeyID.ValueString()
+	output, err := conn.GenerateMac(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("generating MAC with KMS Key (%s)", keyID), err.Error())
+
+		return
+	}
+
+	data.MAC = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.Mac))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type macEphemeralResourceModel struct {
+	framework.WithRegionModel
+	GrantTokens  fwtypes.ListOfString                          `tfsdk:"grant_tokens"`
+	KeyID        types.String                                  `tfsdk:"key_id"`
+	MAC          types.String                                  `tfsdk:"mac"`
+	MACAlgorithm fwtypes.StringEnum[awstypes.MacAlgorithmSpec] `tfsdk:"mac_algorithm"`
+	Message      types.String                                  `tfsdk:"message"`
+}
@@ -20,12 +20,30 @@ type servicePackage struct{}
 
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newDataKeyEphemeralResource,
+			TypeName: "aws_kms_data_key",
+			Name:     "Data Key",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newMACEphemeralResource,
+			TypeName: "aws_kms_mac",
+			Name:     "MAC",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSecretsEphemeralResource,
 			TypeName: "aws_kms_secrets",
 			Name:     "Secrets",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newSignEphemeralResource,
+			TypeName: "aws_kms_sign",
+			Name:     "Sign",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
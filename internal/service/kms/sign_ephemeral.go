@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_kms_sign, name="Sign")
+func newSignEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signEphemeralResource{}, nil
+}
+
+type signEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signEphemeralResourceModel]
+}
+
+func (e *signEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"grant_tokens": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"message_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MessageType](),
+				Optional:   true,
+			},
+			"signature": schema.StringAttribute{
+				Computed: true,
+			},
+			"signing_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SigningAlgorithmSpec](),
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (e *signEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data signEphemeralResourceModel
+	conn := e.Meta().KMSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := kms.SignInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithIgnoredFieldNamesAppend("Message"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	message, err := itypes.Base64Decode(data.Message.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrMessage), "invalid base64 value for message", err.Error())
+
+		return
+	}
+
+	input.Message = message
+
+	keyID := data.KeyID.ValueString()
+	output, err := conn.Sign(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("signing message with KMS Key (%s)", keyID), err.Error())
+
+		return
+	}
+
+	data.Signature = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.Signature))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type signEphemeralResourceModel struct {
+	framework.WithRegionModel
+	GrantTokens      fwtypes.ListOfString                              `tfsdk:"grant_tokens"`
+	KeyID            types.String                                      `tfsdk:"key_id"`
+	Message          types.String                                      `tfsdk:"message"`
+	MessageType      fwtypes.StringEnum[awstypes.MessageType]          `tfsdk:"message_type"`
+	Signature        types.String                                      `tfsdk:"signature"`
+	SigningAlgorithm fwtypes.StringEnum[awstypes.SigningAlgorithmSpec] `tfsdk:"signing_algorithm"`
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @SDKListResource("aws_lambda_function", name="Function")
+func newFunctionListResource() list.ListResourceWithConfigure {
+	return &functionListResource{}
+}
+
+type functionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *functionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query functionListResourceModel
+	if diags := request.Config.Get(ctx, &query); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().LambdaClient(ctx)
+
+	var input lambda.ListFunctionsInput
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := lambda.NewListFunctionsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(framework.ListResultError("listing Lambda Functions", err))
+				return
+			}
+
+			for _, v := range page.Functions {
+				functionName := aws.ToString(v.FunctionName)
+
+				d := l.ResourceData()
+				d.SetId(functionName)
+				d.Set("function_name", functionName)
+
+				result, ok := l.NewListResult(ctx, request, d, functionName)
+				if !ok {
+					continue
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type functionListResourceModel struct {
+	framework.WithRegionModel
+}
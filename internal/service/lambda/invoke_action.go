@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// @Action("aws_lambda_invoke", name="Invoke")
+func newInvokeAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &invokeAction{}, nil
+}
+
+type invokeAction struct {
+	framework.ActionWithModel[invokeActionModel]
+}
+
+func (a *invokeAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Invokes a Lambda function.",
+		Attributes: map[string]schema.Attribute{
+			"client_context": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base64-encoded data about the invoking client to pass to the function in the context object.",
+			},
+			"function_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name, ARN or partial ARN of the Lambda function to invoke.",
+			},
+			"invocation_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.InvocationType](),
+				Optional:    true,
+				Description: "Invocation type. Defaults to `RequestResponse`.",
+			},
+			"log_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.LogType](),
+				Optional:    true,
+				Description: "Set to `Tail` to report the last 4 KB of the execution log as action progress. Only supported for synchronous invocations.",
+			},
+			"payload": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON payload to pass to the function.",
+				Validators: []validator.String{
+					validators.JSON(),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version or alias of the function to invoke.",
+			},
+		},
+	}
+}
+
+func (a *invokeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data invokeActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().LambdaClient(ctx)
+
+	var input lambda.InvokeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if input.InvocationType == "" {
+		input.InvocationType = awstypes.InvocationTypeRequestResponse
+	}
+
+	functionName := aws.ToString(input.FunctionName)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Invoking Lambda Function (%s)", functionName),
+	})
+
+	output, err := conn.Invoke(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), err.Error())
+		return
+	}
+
+	if v := aws.ToString(output.LogResult); v != "" {
+		if log, err := base64.StdEncoding.DecodeString(v); err == nil {
+			response.SendProgress(action.InvokeProgressEvent{
+				Message: string(log),
+			})
+		}
+	}
+
+	if v := aws.ToString(output.FunctionError); v != "" {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), fmt.Sprintf("%s: %s", v, string(output.Payload)))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Lambda Function (%s) invoked, status code %d", functionName, output.StatusCode),
+	})
+}
+
+type invokeActionModel struct {
+	framework.WithRegionModel
+	ClientContext  types.String                                `tfsdk:"client_context"`
+	FunctionName   types.String                                `tfsdk:"function_name"`
+	InvocationType fwtypes.StringEnum[awstypes.InvocationType] `tfsdk:"invocation_type"`
+	LogType        fwtypes.StringEnum[awstypes.LogType]        `tfsdk:"log_type"`
+	Payload        types.String                                `tfsdk:"payload"`
+	Qualifier      types.String                                `tfsdk:"qualifier"`
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/memorydb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,6 +54,19 @@ func resourceUser() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"password_wo": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							ValidateFunc:  validation.StringLenBetween(16, 128),
+							ConflictsWith: []string{"authentication_mode.0.passwords"},
+							RequiredWith:  []string{"authentication_mode.0.password_wo_version"},
+						},
+						"password_wo_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							RequiredWith: []string{"authentication_mode.0.password_wo"},
+						},
 						"passwords": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -62,8 +76,9 @@ func resourceUser() *schema.Resource {
 								Type:         schema.TypeString,
 								ValidateFunc: validation.StringLenBetween(16, 128),
 							},
-							Set:       schema.HashString,
-							Sensitive: true,
+							Set:           schema.HashString,
+							Sensitive:     true,
+							ConflictsWith: []string{"authentication_mode.0.password_wo"},
 						},
 						"password_count": {
 							Type:     schema.TypeInt,
@@ -106,6 +121,17 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 
 	if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.AuthenticationMode = expandAuthenticationMode(v.([]any)[0].(map[string]any))
+
+		// get write-only value from configuration
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			input.AuthenticationMode.Passwords = []string{passwordWO}
+		}
 	}
 
 	_, err := conn.CreateUser(ctx, input)
@@ -139,9 +165,10 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 	d.Set(names.AttrARN, user.ARN)
 	if v := user.Authentication; v != nil {
 		tfMap := map[string]any{
-			"passwords":      d.Get("authentication_mode.0.passwords"),
-			"password_count": aws.ToInt32(v.PasswordCount),
-			names.AttrType:   v.Type,
+			"passwords":           d.Get("authentication_mode.0.passwords"),
+			"password_count":      aws.ToInt32(v.PasswordCount),
+			"password_wo_version": d.Get("authentication_mode.0.password_wo_version"),
+			names.AttrType:        v.Type,
 		}
 
 		if err := d.Set("authentication_mode", []any{tfMap}); err != nil {
@@ -169,6 +196,17 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 
 		if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 			input.AuthenticationMode = expandAuthenticationMode(v.([]any)[0].(map[string]any))
+
+			// The write-only password is sent whenever the authentication mode is, as it isn't stored in state.
+			passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if passwordWO != "" {
+				input.AuthenticationMode.Passwords = []string{passwordWO}
+			}
 		}
 
 		_, err := conn.UpdateUser(ctx, input)
@@ -105,6 +105,11 @@ func resourceDomain() *schema.Resource {
 				return !inPlaceEncryptionEnableVersion(d.Get(names.AttrEngineVersion).(string))
 			}),
 			customdiff.ForceNewIf("advanced_security_options.0.enabled", func(_ context.Context, d *schema.ResourceDiff, meta any) bool {
+				// Removing the block from configuration does not disable advanced security options.
+				if v := d.GetRawConfig().GetAttr("advanced_security_options"); v.IsNull() || v.LengthInt() == 0 {
+					return false
+				}
+
 				o, n := d.GetChange("advanced_security_options.0.enabled")
 				if o.(bool) && !n.(bool) {
 					return true
@@ -126,10 +131,10 @@ func resourceDomain() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"advanced_security_options": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"anonymous_auth_enabled": {
@@ -162,9 +167,22 @@ func resourceDomain() *schema.Resource {
 										Optional: true,
 									},
 									"master_user_password": {
-										Type:      schema.TypeString,
-										Optional:  true,
-										Sensitive: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Sensitive:     true,
+										ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password_wo"},
+									},
+									"master_user_password_wo": {
+										Type:          schema.TypeString,
+										Optional:      true,
+										WriteOnly:     true,
+										ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password"},
+										RequiredWith:  []string{"advanced_security_options.0.master_user_options.0.master_user_password_wo_version"},
+									},
+									"master_user_password_wo_version": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										RequiredWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password_wo"},
 									},
 								},
 							},
@@ -714,6 +732,11 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	if v, ok := d.GetOk("advanced_security_options"); ok {
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]any))
+
+		diags = append(diags, expandMasterUserPasswordWO(d, input.AdvancedSecurityOptions)...)
+		if diags.HasError() {
+			return diags
+		}
 	}
 
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]any)) > 0 {
@@ -1042,6 +1065,12 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 
 		if d.HasChange("advanced_security_options") {
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]any))
+
+			// The write-only password is sent whenever the advanced security options are, as it isn't stored in state.
+			diags = append(diags, expandMasterUserPasswordWO(d, input.AdvancedSecurityOptions)...)
+			if diags.HasError() {
+				return diags
+			}
 		}
 
 		if d.HasChange("auto_tune_options") {
@@ -8,7 +8,10 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -336,6 +339,25 @@ func flattenESSAMLIdpOptions(SAMLIdp *awstypes.SAMLIdp) []any {
 	return []any{m}
 }
 
+// expandMasterUserPasswordWO sets the master user password from the write-only master_user_password_wo argument.
+func expandMasterUserPasswordWO(d *schema.ResourceData, apiObject *awstypes.AdvancedSecurityOptionsInput) diag.Diagnostics {
+	if apiObject == nil || apiObject.MasterUserOptions == nil {
+		return nil
+	}
+
+	// get write-only value from configuration
+	passwordWO, diags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("advanced_security_options").IndexInt(0).GetAttr("master_user_options").IndexInt(0).GetAttr("master_user_password_wo"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" {
+		apiObject.MasterUserOptions.MasterUserPassword = aws.String(passwordWO)
+	}
+
+	return diags
+}
+
 func getMasterUserOptions(d *schema.ResourceData) []any {
 	if v, ok := d.GetOk("advanced_security_options"); ok {
 		options := v.([]any)
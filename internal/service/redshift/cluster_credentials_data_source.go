@@ -5,96 +5,144 @@ package redshift
 
 import (
 	"context"
-	"time"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/redshift"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKDataSource("aws_redshift_cluster_credentials", name="Cluster Credentials")
-func dataSourceClusterCredentials() *schema.Resource {
-	return &schema.Resource{
-		ReadWithoutTimeout: dataSourceClusterCredentialsRead,
+const (
+	clusterCredentialsDefaultDurationSeconds = 900
+)
+
+// @FrameworkDataSource("aws_redshift_cluster_credentials", name="Cluster Credentials")
+func newClusterCredentialsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterCredentialsDataSource{}, nil
+}
 
-		Schema: map[string]*schema.Schema{
-			"auto_create": {
-				Type:     schema.TypeBool,
+type clusterCredentialsDataSource struct {
+	framework.DataSourceWithModel[clusterCredentialsDataSourceModel]
+}
+
+func (d *clusterCredentialsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_create": schema.BoolAttribute{
 				Optional: true,
 			},
-			names.AttrClusterIdentifier: {
-				Type:     schema.TypeString,
+			names.AttrClusterIdentifier: schema.StringAttribute{
 				Required: true,
 			},
-			"db_groups": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			"db_groups": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
 			},
-			"db_name": {
-				Type:     schema.TypeString,
+			"db_name": schema.StringAttribute{
 				Optional: true,
 			},
-			"db_password": {
-				Type:      schema.TypeString,
+			"db_password": schema.StringAttribute{
 				Computed:  true,
 				Sensitive: true,
 			},
-			"db_user": {
-				Type:     schema.TypeString,
+			"db_user": schema.StringAttribute{
 				Required: true,
 			},
-			"duration_seconds": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      900,
-				ValidateFunc: validation.IntBetween(900, 3600),
-			},
-			"expiration": {
-				Type:     schema.TypeString,
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
 				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 3600),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
 			},
+			names.AttrID: framework.IDAttribute(),
 		},
 	}
 }
 
-func dataSourceClusterCredentialsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftClient(ctx)
+func (d *clusterCredentialsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterCredentialsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := data.ClusterIdentifier.ValueString()
+	err := getClusterCredentials(ctx, d.Meta(), &data.clusterCredentialsModel)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Cluster Credentials for Cluster (%s)", clusterID), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, clusterID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// clusterCredentialsModel is shared by the cluster credentials data source and ephemeral resource.
+type clusterCredentialsModel struct {
+	framework.WithRegionModel
+	AutoCreate        types.Bool          `tfsdk:"auto_create"`
+	ClusterIdentifier types.String        `tfsdk:"cluster_identifier"`
+	DBGroups          fwtypes.SetOfString `tfsdk:"db_groups"`
+	DBName            types.String        `tfsdk:"db_name"`
+	DBPassword        types.String        `tfsdk:"db_password"`
+	DBUser            types.String        `tfsdk:"db_user"`
+	DurationSeconds   types.Int64         `tfsdk:"duration_seconds"`
+	Expiration        timetypes.RFC3339   `tfsdk:"expiration"`
+}
+
+type clusterCredentialsDataSourceModel struct {
+	clusterCredentialsModel
+	ID types.String `tfsdk:"id"`
+}
+
+// getClusterCredentials requests temporary database credentials for the model's cluster and sets the model's computed attributes.
+func getClusterCredentials(ctx context.Context, c *conns.AWSClient, data *clusterCredentialsModel) error {
+	conn := c.RedshiftClient(ctx)
 
-	clusterID := d.Get(names.AttrClusterIdentifier).(string)
-	input := &redshift.GetClusterCredentialsInput{
-		AutoCreate:        aws.Bool(d.Get("auto_create").(bool)),
-		ClusterIdentifier: aws.String(clusterID),
-		DbUser:            aws.String(d.Get("db_user").(string)),
-		DurationSeconds:   aws.Int32(int32(d.Get("duration_seconds").(int))),
+	if data.DurationSeconds.IsNull() || data.DurationSeconds.IsUnknown() {
+		data.DurationSeconds = fwflex.Int64ValueToFramework(ctx, clusterCredentialsDefaultDurationSeconds)
 	}
 
-	if v, ok := d.GetOk("db_groups"); ok && v.(*schema.Set).Len() > 0 {
-		input.DbGroups = flex.ExpandStringValueSet(v.(*schema.Set))
+	input := redshift.GetClusterCredentialsInput{
+		AutoCreate:        aws.Bool(data.AutoCreate.ValueBool()),
+		ClusterIdentifier: fwflex.StringFromFramework(ctx, data.ClusterIdentifier),
+		DbName:            fwflex.StringFromFramework(ctx, data.DBName),
+		DbUser:            fwflex.StringFromFramework(ctx, data.DBUser),
+		DurationSeconds:   fwflex.Int32FromFrameworkInt64(ctx, data.DurationSeconds),
 	}
 
-	if v, ok := d.GetOk("db_name"); ok {
-		input.DbName = aws.String(v.(string))
+	if v := fwflex.ExpandFrameworkStringValueSet(ctx, data.DBGroups); len(v) > 0 {
+		input.DbGroups = v
 	}
 
-	creds, err := conn.GetClusterCredentials(ctx, input)
+	output, err := conn.GetClusterCredentials(ctx, &input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Redshift Cluster Credentials for Cluster (%s): %s", clusterID, err)
+		return err
 	}
 
-	d.SetId(clusterID)
-
-	d.Set("db_password", creds.DbPassword)
-	d.Set("db_user", creds.DbUser)
-	d.Set("expiration", aws.ToTime(creds.Expiration).Format(time.RFC3339))
+	data.DBPassword = fwflex.StringToFramework(ctx, output.DbPassword)
+	// The returned database user name is prefixed, e.g. "IAM:", and must be used when connecting.
+	data.DBUser = fwflex.StringToFramework(ctx, output.DbUser)
+	data.Expiration = fwflex.TimeToFramework(ctx, output.Expiration)
 
-	return diags
+	return nil
 }
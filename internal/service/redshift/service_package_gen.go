@@ -18,8 +18,25 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newClusterCredentialsEphemeralResource,
+			TypeName: "aws_redshift_cluster_credentials",
+			Name:     "Cluster Credentials",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newClusterCredentialsDataSource,
+			TypeName: "aws_redshift_cluster_credentials",
+			Name:     "Cluster Credentials",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newDataSharesDataSource,
 			TypeName: "aws_redshift_data_shares",
@@ -82,12 +99,6 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Tags:     unique.Make(inttypes.ServicePackageResourceTags{}),
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
-		{
-			Factory:  dataSourceClusterCredentials,
-			TypeName: "aws_redshift_cluster_credentials",
-			Name:     "Cluster Credentials",
-			Region:   unique.Make(inttypes.ResourceRegionDefault()),
-		},
 		{
 			Factory:  dataSourceOrderableCluster,
 			TypeName: "aws_redshift_orderable_cluster",
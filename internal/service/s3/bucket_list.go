@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_s3_bucket", name="Bucket")
+func newBucketListResource() list.ListResourceWithConfigure {
+	return &bucketListResource{}
+}
+
+type bucketListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrPrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the results to bucket names that begin with the specified prefix.",
+			},
+		},
+	}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query bucketListResourceModel
+	if diags := request.Config.Get(ctx, &query); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	c := l.Meta()
+	conn := c.S3Client(ctx)
+
+	// Only general purpose buckets in the configured Region are listed.
+	input := s3.ListBucketsInput{
+		BucketRegion: aws.String(c.Region(ctx)),
+		Prefix:       fwflex.StringFromFramework(ctx, query.Prefix),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := s3.NewListBucketsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(framework.ListResultError("listing S3 Buckets", err))
+				return
+			}
+
+			for _, v := range page.Buckets {
+				bucket := aws.ToString(v.Name)
+
+				d := l.ResourceData()
+				d.SetId(bucket)
+				d.Set(names.AttrBucket, bucket)
+
+				result, ok := l.NewListResult(ctx, request, d, bucket)
+				if !ok {
+					continue
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type bucketListResourceModel struct {
+	framework.WithRegionModel
+	Prefix types.String `tfsdk:"prefix"`
+}
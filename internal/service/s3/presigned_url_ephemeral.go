@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// presignedURLDefaultExpiresIn is the default validity period of a presigned URL.
+	presignedURLDefaultExpiresIn = 15 * time.Minute
+)
+
+// @EphemeralResource(aws_s3_presigned_url, name="Presigned URL")
+func newPresignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &presignedURLEphemeralResource{}, nil
+}
+
+type presignedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[presignedURLEphemeralResourceModel]
+}
+
+func (e *presignedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					// SigV4 presigned URLs are valid for at most 7 days.
+					int64validator.Between(1, 604800),
+				},
+			},
+			names.AttrKey: schema.StringAttribute{
+				Required: true,
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodGet, http.MethodPut),
+				},
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *presignedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data presignedURLEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, key := data.Bucket.ValueString(), data.Key.ValueString()
+	conn := e.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = e.Meta().S3ExpressClient(ctx)
+	}
+
+	method := http.MethodGet
+	if !data.Method.IsNull() {
+		method = data.Method.ValueString()
+	}
+
+	expiresIn := presignedURLDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	credentials, err := conn.Options().Credentials.Retrieve(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Presigned URL (%s/%s)", bucket, key), fmt.Sprintf("retrieving AWS credentials: %s", err))
+
+		return
+	}
+
+	signingTime := time.Now()
+
+	// A presigned URL stops working when the credentials used to sign it expire.
+	if credentials.CanExpire {
+		if remaining := credentials.Expires.Sub(signingTime).Truncate(time.Second); remaining < expiresIn {
+			if remaining < time.Second {
+				response.Diagnostics.AddError(fmt.Sprintf("creating S3 Presigned URL (%s/%s)", bucket, key), fmt.Sprintf("AWS credentials expired at %s", credentials.Expires.Format(time.RFC3339)))
+
+				return
+			}
+
+			response.Diagnostics.AddAttributeWarning(path.Root("expires_in"),
+				"Presigned URL validity shortened",
+				fmt.Sprintf("The AWS credentials used to sign the URL expire at %s. The URL is valid for %s instead of %s.", credentials.Expires.Format(time.RFC3339), remaining, expiresIn),
+			)
+			expiresIn = remaining
+		}
+	}
+
+	output, err := presignObjectURL(ctx, conn, method, bucket, key, expiresIn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Presigned URL (%s/%s)", bucket, key), err.Error())
+
+		return
+	}
+
+	expiration := signingTime.Add(expiresIn)
+	data.Expiration = timetypes.NewRFC3339TimeValue(expiration)
+	data.URL = fwflex.StringValueToFramework(ctx, output.URL)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type presignedURLEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Bucket     types.String      `tfsdk:"bucket"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	ExpiresIn  types.Int64       `tfsdk:"expires_in"`
+	Key        types.String      `tfsdk:"key"`
+	Method     types.String      `tfsdk:"method"`
+	URL        types.String      `tfsdk:"url"`
+}
+
+// presignObjectURL returns a presigned URL for the specified object and HTTP method.
+// The URL is signed locally with SigV4 using the client's configuration (e.g. path-style addressing) and credentials.
+// S3 Express session authentication is disabled so that presigning directory bucket URLs doesn't call CreateSession.
+func presignObjectURL(ctx context.Context, conn *s3.Client, method, bucket, key string, expiresIn time.Duration) (*v4.PresignedHTTPRequest, error) {
+	client := s3.NewPresignClient(conn, func(o *s3.PresignOptions) {
+		o.Expires = expiresIn
+		o.ClientOptions = append(o.ClientOptions, func(o *s3.Options) {
+			o.DisableS3ExpressSessionAuth = aws.Bool(true)
+		})
+	})
+
+	switch method {
+	case http.MethodGet:
+		return client.PresignGetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	case http.MethodPut:
+		return client.PresignPutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method: %s", method)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameSecretValues = "Secret Values Ephemeral Resource"
+
+	// batchGetSecretValueMaxSecretIDs is the maximum number of secret IDs accepted by BatchGetSecretValue.
+	batchGetSecretValueMaxSecretIDs = 20
+)
+
+// @EphemeralResource(aws_secretsmanager_secret_values, name="Secret Values")
+func newSecretValuesEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &secretValuesEphemeralResource{}, nil
+}
+
+type secretValuesEphemeralResource struct {
+	framework.EphemeralResourceWithModel[secretValuesEphemeralResourceModel]
+}
+
+func (e *secretValuesEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+			},
+			"secret_ids": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Required:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, batchGetSecretValueMaxSecretIDs),
+				},
+			},
+			"secret_strings": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+				Sensitive:  true,
+			},
+			names.AttrValues: schema.MapAttribute{
+				// map[string]map[string]string
+				CustomType: fwtypes.NewMapTypeOf[fwtypes.MapOfString](ctx),
+				Computed:   true,
+				Sensitive:  true,
+			},
+		},
+	}
+}
+
+func (e *secretValuesEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data secretValuesEphemeralResourceModel
+	conn := e.Meta().SecretsManagerClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := secretsmanager.BatchGetSecretValueInput{
+		SecretIdList: fwflex.ExpandFrameworkStringValueSet(ctx, data.SecretIDs),
+	}
+
+	secretValues, err := findSecretValues(ctx, conn, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecretsManager, create.ErrActionReading, ERNameSecretValues, data.SecretIDs.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	arns := make(map[string]string, len(secretValues))
+	secretStrings := make(map[string]string, len(secretValues))
+	values := make(map[string]attr.Value, len(secretValues))
+	for _, v := range secretValues {
+		name := aws.ToString(v.Name)
+		arns[name] = aws.ToString(v.ARN)
+
+		if v.SecretString == nil {
+			continue
+		}
+
+		secretString := aws.ToString(v.SecretString)
+		secretStrings[name] = secretString
+
+		// Only secrets whose value is a JSON object have their keys extracted.
+		m, err := flattenSecretStringJSON(secretString)
+		if err != nil {
+			continue
+		}
+
+		elems := make(map[string]attr.Value, len(m))
+		for k, v := range m {
+			elems[k] = types.StringValue(v)
+		}
+
+		var diags diag.Diagnostics
+		values[name], diags = fwtypes.NewMapValueOf[types.String](ctx, elems)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, arns, &data.ARNs)...)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, secretStrings, &data.SecretStrings)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Values, diags = fwtypes.NewMapValueOf[fwtypes.MapOfString](ctx, values)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type secretValuesEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ARNs          fwtypes.MapOfString                     `tfsdk:"arns"`
+	SecretIDs     fwtypes.SetOfString                     `tfsdk:"secret_ids"`
+	SecretStrings fwtypes.MapOfString                     `tfsdk:"secret_strings"`
+	Values        fwtypes.MapValueOf[fwtypes.MapOfString] `tfsdk:"values"`
+}
+
+func findSecretValues(ctx context.Context, conn *secretsmanager.Client, input *secretsmanager.BatchGetSecretValueInput) ([]awstypes.SecretValueEntry, error) {
+	var output []awstypes.SecretValueEntry
+
+	pages := secretsmanager.NewBatchGetSecretValuePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Secrets that could not be retrieved are reported in Errors rather than failing the request.
+		var errs []error
+		for _, v := range page.Errors {
+			errs = append(errs, fmt.Errorf("%s: %s: %s", aws.ToString(v.SecretId), aws.ToString(v.ErrorCode), aws.ToString(v.Message)))
+		}
+		if err := errors.Join(errs...); err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SecretValues...)
+	}
+
+	return output, nil
+}
+
+// flattenSecretStringJSON returns the top-level keys of a secret string that is a JSON object.
+// String values are returned as is, all other values are returned JSON-encoded.
+func flattenSecretStringJSON(secretString string) (map[string]string, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(secretString), &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, errors.New("secret string is not a JSON object")
+	}
+
+	output := make(map[string]string, len(m))
+	for k, v := range m {
+		var value any
+		if err := json.Unmarshal(v, &value); err != nil {
+			return nil, err
+		}
+		if s, ok := value.(string); ok {
+			output[k] = s
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, v); err != nil {
+			return nil, err
+		}
+		output[k] = buf.String()
+	}
+
+	return output, nil
+}
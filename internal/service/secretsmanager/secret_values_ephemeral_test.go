@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFlattenSecretStringJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		secretString string
+		expected     map[string]string
+		expectError  bool
+	}{
+		"plain text": {
+			secretString: "super-secret",
+			expectError:  true,
+		},
+		"JSON array": {
+			secretString: `["a", "b"]`,
+			expectError:  true,
+		},
+		"JSON null": {
+			secretString: `null`,
+			expectError:  true,
+		},
+		"empty JSON object": {
+			secretString: `{}`,
+			expected:     map[string]string{},
+		},
+		"JSON object": {
+			secretString: `{"username": "admin", "password": "p@ss\"word", "port": 5432, "ssl": true, "options": {"a": [1, 2]}, "none": null}`,
+			expected: map[string]string{
+				"username": "admin",
+				"password": `p@ss"word`,
+				"port":     "5432",
+				"ssl":      "true",
+				"options":  `{"a":[1,2]}`,
+				"none":     "null",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfsecretsmanager.FlattenSecretStringJSON(testCase.secretString)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("FlattenSecretStringJSON(%q) err %t, want %t", testCase.secretString, got, want)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAccSecretsManagerSecretValuesEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretValuesEphemeralResourceConfig_basic(rName1, rName2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("arns").AtMapKey(rName1), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("arns").AtMapKey(rName2), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_strings").AtMapKey(rName2), knownvalue.StringExact("super-secret")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrValues), knownvalue.MapExact(map[string]knownvalue.Check{
+						rName1: knownvalue.MapExact(map[string]knownvalue.Check{
+							names.AttrPassword: knownvalue.StringExact("p@ssword"),
+							names.AttrPort:     knownvalue.StringExact("5432"),
+							names.AttrUsername: knownvalue.StringExact("admin"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccSecretValuesEphemeralResourceConfig_basic(rName1, rName2 string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_values.test"),
+		fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test1" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test1" {
+  secret_id = aws_secretsmanager_secret.test1.id
+  secret_string = jsonencode({
+    username = "admin"
+    password = "p@ssword"
+    port     = 5432
+  })
+}
+
+resource "aws_secretsmanager_secret" "test2" {
+  name = %[2]q
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id     = aws_secretsmanager_secret.test2.id
+  secret_string = "super-secret"
+}
+
+ephemeral "aws_secretsmanager_secret_values" "test" {
+  secret_ids = [
+    aws_secretsmanager_secret_version.test1.secret_id,
+    aws_secretsmanager_secret_version.test2.secret_id,
+  ]
+}
+`, rName1, rName2))
+}
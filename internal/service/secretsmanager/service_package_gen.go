@@ -26,6 +26,12 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Random Password",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newSecretValuesEphemeralResource,
+			TypeName: "aws_secretsmanager_secret_values",
+			Name:     "Secret Values",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSecretVersionEphemeralResource,
 			TypeName: "aws_secretsmanager_secret_version",
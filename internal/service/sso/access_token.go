@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/service/ssooidc"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	attrAccessToken    = "access_token"
+	attrSSOSessionName = "sso_session_name"
+	attrStartURL       = "start_url"
+)
+
+var (
+	// cachedAccessTokenExpressions are the mutually exclusive ways of looking up a cached IAM Identity Center access token.
+	cachedAccessTokenExpressions = path.Expressions{
+		path.MatchRoot(attrSSOSessionName),
+		path.MatchRoot(attrStartURL),
+	}
+	// accessTokenExpressions are the mutually exclusive ways of specifying an IAM Identity Center access token.
+	accessTokenExpressions = append(path.Expressions{
+		path.MatchRoot(attrAccessToken),
+	}, cachedAccessTokenExpressions...)
+)
+
+type cachedAccessTokenModel struct {
+	SSOSessionName types.String `tfsdk:"sso_session_name"`
+	StartURL       types.String `tfsdk:"start_url"`
+}
+
+type accessTokenModel struct {
+	cachedAccessTokenModel
+	AccessToken types.String `tfsdk:"access_token"`
+}
+
+// findAccessToken returns the IAM Identity Center access token for an operator's session.
+// Unless the token is specified directly, it is read from the AWS CLI's SSO token cache,
+// keyed by SSO session name or, for legacy configurations, start URL, and refreshed if it has expired.
+// See https://docs.aws.amazon.com/sdkref/latest/guide/understanding-sso.html.
+func findAccessToken(ctx context.Context, c *conns.AWSClient, data accessTokenModel) (string, error) {
+	if v := data.AccessToken.ValueString(); v != "" {
+		return v, nil
+	}
+
+	return findCachedAccessToken(ctx, c, data.cachedAccessTokenModel)
+}
+
+// findCachedAccessToken returns the IAM Identity Center access token cached by the AWS CLI for an operator's session.
+func findCachedAccessToken(ctx context.Context, c *conns.AWSClient, data cachedAccessTokenModel) (string, error) {
+	key := data.SSOSessionName.ValueString()
+	if key == "" {
+		key = data.StartURL.ValueString()
+	}
+
+	cachedTokenFilepath, err := ssocreds.StandardCachedTokenFilepath(key)
+	if err != nil {
+		return "", err
+	}
+
+	client := ssooidc.NewFromConfig(c.AwsConfig(ctx), func(o *ssooidc.Options) {
+		o.Region = c.Region(ctx)
+	})
+	token, err := ssocreds.NewSSOTokenProvider(client, cachedTokenFilepath).RetrieveBearerToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return token.Value, nil
+}
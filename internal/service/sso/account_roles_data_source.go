@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_sso_account_roles", name="Account Roles")
+func newAccountRolesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &accountRolesDataSource{}, nil
+}
+
+type accountRolesDataSource struct {
+	framework.DataSourceWithModel[accountRolesDataSourceModel]
+}
+
+func (d *accountRolesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Required: true,
+			},
+			"roles": framework.DataSourceComputedListOfObjectAttribute[roleInfoModel](ctx),
+			attrSSOSessionName: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(cachedAccessTokenExpressions...),
+				},
+			},
+			attrStartURL: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *accountRolesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accountRolesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	accessToken, err := findCachedAccessToken(ctx, d.Meta(), data.cachedAccessTokenModel)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading IAM Identity Center access token", err.Error())
+
+		return
+	}
+
+	conn := d.Meta().SSOClient(ctx)
+
+	accountID := data.AccountID.ValueString()
+	input := sso.ListAccountRolesInput{
+		AccessToken: aws.String(accessToken),
+		AccountId:   aws.String(accountID),
+	}
+	output, err := findAccountRoles(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Account (%s) Roles", accountID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Roles)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findAccountRoles(ctx context.Context, conn *sso.Client, input *sso.ListAccountRolesInput) ([]awstypes.RoleInfo, error) {
+	var output []awstypes.RoleInfo
+
+	pages := sso.NewListAccountRolesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RoleList...)
+	}
+
+	return output, nil
+}
+
+type accountRolesDataSourceModel struct {
+	framework.WithRegionModel
+	cachedAccessTokenModel
+	AccountID types.String                                   `tfsdk:"account_id"`
+	Roles     fwtypes.ListNestedObjectValueOf[roleInfoModel] `tfsdk:"roles"`
+}
+
+type roleInfoModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	RoleName  types.String `tfsdk:"role_name"`
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_sso_role_credentials, name="Role Credentials")
+func newRoleCredentialsEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &roleCredentialsEphemeralResource{}, nil
+}
+
+type roleCredentialsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[roleCredentialsEphemeralResourceModel]
+}
+
+func (e *roleCredentialsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			attrAccessToken: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(accessTokenExpressions...),
+				},
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Required: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			attrSSOSessionName: schema.StringAttribute{
+				Optional: true,
+			},
+			attrStartURL: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *roleCredentialsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data roleCredentialsEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	accessToken, err := findAccessToken(ctx, e.Meta(), data.accessTokenModel)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading IAM Identity Center access token", err.Error())
+
+		return
+	}
+
+	conn := e.Meta().SSOClient(ctx)
+
+	accountID, roleName := data.AccountID.ValueString(), data.RoleName.ValueString()
+	input := sso.GetRoleCredentialsInput{
+		AccessToken: aws.String(accessToken),
+		AccountId:   aws.String(accountID),
+		RoleName:    aws.String(roleName),
+	}
+	output, err := conn.GetRoleCredentials(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Role Credentials (%s/%s)", accountID, roleName), err.Error())
+
+		return
+	}
+
+	credentials := output.RoleCredentials
+	expiration := time.UnixMilli(credentials.Expiration).UTC()
+	data.AccessKeyID = fwflex.StringToFramework(ctx, credentials.AccessKeyId)
+	data.Expiration = timetypes.NewRFC3339TimeValue(expiration)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type roleCredentialsEphemeralResourceModel struct {
+	framework.WithRegionModel
+	accessTokenModel
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	AccountID       types.String      `tfsdk:"account_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	RoleName        types.String      `tfsdk:"role_name"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
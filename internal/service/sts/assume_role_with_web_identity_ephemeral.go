@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"maps"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRoleWithWebIdentity = "Ephemeral Resource Assume Role With Web Identity"
+)
+
+// @EphemeralResource(aws_sts_assume_role_with_web_identity, name="Assume Role With Web Identity")
+func newAssumeRoleWithWebIdentityEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleWithWebIdentityEphemeralResource{}, nil
+}
+
+type assumeRoleWithWebIdentityEphemeralResource struct {
+	framework.EphemeralResourceWithModel[assumeRoleWithWebIdentityEphemeralResourceModel]
+}
+
+func (e *assumeRoleWithWebIdentityEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"assumed_role_arn": schema.StringAttribute{
+			Computed: true,
+		},
+		"assumed_role_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"audience": schema.StringAttribute{
+			Computed: true,
+		},
+		"duration_seconds": schema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.Between(900, 43200),
+			},
+		},
+		"identity_provider": schema.StringAttribute{
+			Computed: true,
+		},
+		names.AttrPolicy: schema.StringAttribute{
+			CustomType: fwtypes.IAMPolicyType,
+			Optional:   true,
+		},
+		"policy_arns": schema.SetAttribute{
+			CustomType: fwtypes.SetOfARNType,
+			Optional:   true,
+		},
+		"provider_id": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(4, 2048),
+			},
+		},
+		names.AttrRoleARN: schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Required:   true,
+		},
+		"role_session_name": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 64),
+			},
+		},
+		"subject_from_web_identity_token": schema.StringAttribute{
+			Computed: true,
+		},
+		"web_identity_token": schema.StringAttribute{
+			Required:  true,
+			Sensitive: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(4, 20000),
+			},
+		},
+	}
+	maps.Copy(attributes, credentialsSchemaAttributes())
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *assumeRoleWithWebIdentityEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data assumeRoleWithWebIdentityEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	var input sts.AssumeRoleWithWebIdentityInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithIgnoredFieldNamesAppend("PolicyArns"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.PolicyArns = expandPolicyDescriptorTypes(ctx, data.PolicyARNs)
+
+	output, err := conn.AssumeRoleWithWebIdentity(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionCreating, ERNameAssumeRoleWithWebIdentity, data.RoleARN.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if output.AssumedRoleUser != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, output.AssumedRoleUser.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, output.AssumedRoleUser.AssumedRoleId)
+	}
+	data.Audience = fwflex.StringToFramework(ctx, output.Audience)
+	data.IdentityProvider = fwflex.StringToFramework(ctx, output.Provider)
+	data.SubjectFromWebIdentityToken = fwflex.StringToFramework(ctx, output.SubjectFromWebIdentityToken)
+	data.credentialsModel = flattenCredentials(ctx, output.Credentials)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type assumeRoleWithWebIdentityEphemeralResourceModel struct {
+	credentialsModel
+	AssumedRoleARN              types.String      `tfsdk:"assumed_role_arn"`
+	AssumedRoleID               types.String      `tfsdk:"assumed_role_id"`
+	Audience                    types.String      `tfsdk:"audience"`
+	DurationSeconds             types.Int64       `tfsdk:"duration_seconds"`
+	IdentityProvider            types.String      `tfsdk:"identity_provider"`
+	Policy                      fwtypes.IAMPolicy `tfsdk:"policy"`
+	PolicyARNs                  fwtypes.SetOfARN  `tfsdk:"policy_arns"`
+	ProviderID                  types.String      `tfsdk:"provider_id"`
+	RoleARN                     fwtypes.ARN       `tfsdk:"role_arn"`
+	RoleSessionName             types.String      `tfsdk:"role_session_name"`
+	SubjectFromWebIdentityToken types.String      `tfsdk:"subject_from_web_identity_token"`
+	WebIdentityToken            types.String      `tfsdk:"web_identity_token"`
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func credentialsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"access_key_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"expiration": schema.StringAttribute{
+			CustomType: timetypes.RFC3339Type{},
+			Computed:   true,
+		},
+		"secret_access_key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+		"session_token": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+type credentialsModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
+
+func flattenCredentials(ctx context.Context, apiObject *awstypes.Credentials) credentialsModel {
+	if apiObject == nil {
+		return credentialsModel{
+			AccessKeyID:     types.StringNull(),
+			Expiration:      timetypes.NewRFC3339Null(),
+			SecretAccessKey: types.StringNull(),
+			SessionToken:    types.StringNull(),
+		}
+	}
+
+	return credentialsModel{
+		AccessKeyID:     fwflex.StringToFramework(ctx, apiObject.AccessKeyId),
+		Expiration:      fwflex.TimeToFramework(ctx, apiObject.Expiration),
+		SecretAccessKey: fwflex.StringToFramework(ctx, apiObject.SecretAccessKey),
+		SessionToken:    fwflex.StringToFramework(ctx, apiObject.SessionToken),
+	}
+}
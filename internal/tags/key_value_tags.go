@@ -371,18 +371,19 @@ func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
 }
 
 // Chunks returns a slice of KeyValueTags, each of the specified size.
+// Tags are assigned to chunks in key order.
 func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
 	result := []KeyValueTags{}
 
 	i := 0
 	var chunk KeyValueTags
-	for k, v := range tags {
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
 		if i%size == 0 {
 			chunk = make(KeyValueTags)
 			result = append(result, chunk)
 		}
 
-		chunk[k] = v
+		chunk[k] = tags[k]
 
 		i++
 	}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package telemetry
+
+import (
+	"os"
+)
+
+const (
+	envVarTelemetryFile = "TF_AWS_TELEMETRY_FILE"
+)
+
+// IsEnabled indicates whether telemetry collection is enabled
+//
+// Returns true if the TF_AWS_TELEMETRY_FILE environment variable is set to a
+// non-empty value.
+func IsEnabled() bool {
+	return Path() != ""
+}
+
+// Path returns the file to which the telemetry summary is appended
+func Path() string {
+	return os.Getenv(envVarTelemetryFile)
+}
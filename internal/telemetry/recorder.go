@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package telemetry
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/version"
+)
+
+// Kinds of provider-defined types for which operations are recorded.
+const (
+	KindAction            = "action"
+	KindDataSource        = "data_source"
+	KindEphemeralResource = "ephemeral_resource"
+	KindResource          = "resource"
+)
+
+// Recorder accumulates per-resource operation latencies and AWS API call statistics.
+//
+// In-flight operations are identified by an opaque key. The provider uses the
+// per-request resource context value (conns.InContext), so API calls made while
+// handling a request are attributed to that request's operation.
+type Recorder struct {
+	mutex        sync.Mutex
+	now          func() time.Time
+	startTime    time.Time
+	inFlight     map[any]*inFlightOperation
+	resources    map[resourceKey]*resourceStats
+	unattributed map[apiCallKey]*apiCallStats
+}
+
+type resourceKey struct {
+	kind     string
+	typeName string
+}
+
+type apiCallKey struct {
+	service   string
+	operation string
+}
+
+type inFlightOperation struct {
+	resourceKey
+	operation string
+	start     time.Time
+	apiCalls  int
+	retries   int
+}
+
+type resourceStats struct {
+	operations map[string]*operationStats
+	apiCalls   map[apiCallKey]*apiCallStats
+}
+
+type operationStats struct {
+	count    int
+	errors   int
+	total    time.Duration
+	max      time.Duration
+	apiCalls int
+	retries  int
+}
+
+type apiCallStats struct {
+	count   int
+	retries int
+	errors  int
+}
+
+// NewRecorder returns a new, empty Recorder.
+func NewRecorder() *Recorder {
+	return newRecorder(time.Now)
+}
+
+func newRecorder(now func() time.Time) *Recorder {
+	return &Recorder{
+		now:          now,
+		startTime:    now(),
+		inFlight:     make(map[any]*inFlightOperation),
+		resources:    make(map[resourceKey]*resourceStats),
+		unattributed: make(map[apiCallKey]*apiCallStats),
+	}
+}
+
+// StartOperation records the start of an operation, e.g. "Read", on the specified resource type.
+func (r *Recorder) StartOperation(key any, kind, typeName, operation string) {
+	if key == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.inFlight[key] = &inFlightOperation{
+		resourceKey: resourceKey{kind: kind, typeName: typeName},
+		operation:   operation,
+		start:       r.now(),
+	}
+}
+
+// FinishOperation records the end of the in-flight operation identified by key.
+func (r *Recorder) FinishOperation(key any, failed bool) {
+	if key == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	op, ok := r.inFlight[key]
+	if !ok {
+		return
+	}
+	delete(r.inFlight, key)
+
+	stats := r.resource(op.resourceKey).operation(op.operation)
+	elapsed := r.now().Sub(op.start)
+	stats.count++
+	if failed {
+		stats.errors++
+	}
+	stats.total += elapsed
+	stats.max = max(stats.max, elapsed)
+	stats.apiCalls += op.apiCalls
+	stats.retries += op.retries
+}
+
+// RecordAPICall records a completed AWS API call.
+// The call is attributed to the in-flight operation identified by key, if any.
+func (r *Recorder) RecordAPICall(key any, service, operation string, attempts int, failed bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	retries := max(attempts-1, 0)
+	k := apiCallKey{service: service, operation: operation}
+
+	var stats *apiCallStats
+	if op, ok := r.inFlight[key]; ok {
+		op.apiCalls++
+		op.retries += retries
+		stats = r.resource(op.resourceKey).apiCall(k)
+	} else {
+		stats = r.unattributed[k]
+		if stats == nil {
+			stats = &apiCallStats{}
+			r.unattributed[k] = stats
+		}
+	}
+
+	stats.count++
+	stats.retries += retries
+	if failed {
+		stats.errors++
+	}
+}
+
+// IsEmpty returns whether no operations or API calls have been recorded.
+func (r *Recorder) IsEmpty() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return len(r.resources) == 0 && len(r.unattributed) == 0
+}
+
+// Summary returns the recorded statistics as JSON.
+func (r *Recorder) Summary() ([]byte, error) {
+	return json.Marshal(r.summary())
+}
+
+// AppendSummary appends the recorded statistics as a single line of JSON to the specified file.
+// Nothing is written if no operations or API calls have been recorded.
+func (r *Recorder) AppendSummary(path string) error {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	b, err := r.Summary()
+	if err != nil {
+		return fmt.Errorf("encoding telemetry summary: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening telemetry file (%s): %w", path, err)
+	}
+
+	// A single write keeps concurrent appends from multiple provider processes on separate lines.
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("writing telemetry file (%s): %w", path, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing telemetry file (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+func (r *Recorder) resource(k resourceKey) *resourceStats {
+	v := r.resources[k]
+	if v == nil {
+		v = &resourceStats{
+			operations: make(map[string]*operationStats),
+			apiCalls:   make(map[apiCallKey]*apiCallStats),
+		}
+		r.resources[k] = v
+	}
+
+	return v
+}
+
+func (s *resourceStats) operation(name string) *operationStats {
+	v := s.operations[name]
+	if v == nil {
+		v = &operationStats{}
+		s.operations[name] = v
+	}
+
+	return v
+}
+
+func (s *resourceStats) apiCall(k apiCallKey) *apiCallStats {
+	v := s.apiCalls[k]
+	if v == nil {
+		v = &apiCallStats{}
+		s.apiCalls[k] = v
+	}
+
+	return v
+}
+
+type summary struct {
+	ProviderVersion      string            `json:"provider_version"`
+	PID                  int               `json:"pid"`
+	StartTime            time.Time         `json:"start_time"`
+	EndTime              time.Time         `json:"end_time"`
+	Resources            []resourceSummary `json:"resources"`
+	UnattributedAPICalls []apiCallSummary  `json:"unattributed_api_calls,omitempty"`
+}
+
+type resourceSummary struct {
+	Kind       string             `json:"kind"`
+	TypeName   string             `json:"type_name"`
+	Operations []operationSummary `json:"operations"`
+	APICalls   []apiCallSummary   `json:"api_calls,omitempty"`
+}
+
+type operationSummary struct {
+	Operation     string `json:"operation"`
+	Count         int    `json:"count"`
+	Errors        int    `json:"errors"`
+	TotalMillis   int64  `json:"total_ms"`
+	AverageMillis int64  `json:"average_ms"`
+	MaxMillis     int64  `json:"max_ms"`
+	APICalls      int    `json:"api_calls"`
+	Retries       int    `json:"retries"`
+}
+
+type apiCallSummary struct {
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+	Count     int    `json:"count"`
+	Retries   int    `json:"retries"`
+	Errors    int    `json:"errors"`
+}
+
+func (r *Recorder) summary() summary {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	s := summary{
+		ProviderVersion:      version.ProviderVersion,
+		PID:                  os.Getpid(),
+		StartTime:            r.startTime.UTC(),
+		EndTime:              r.now().UTC(),
+		Resources:            make([]resourceSummary, 0, len(r.resources)),
+		UnattributedAPICalls: apiCallSummaries(r.unattributed),
+	}
+
+	for k, v := range r.resources {
+		resource := resourceSummary{
+			Kind:       k.kind,
+			TypeName:   k.typeName,
+			Operations: make([]operationSummary, 0, len(v.operations)),
+			APICalls:   apiCallSummaries(v.apiCalls),
+		}
+
+		for name, v := range v.operations {
+			resource.Operations = append(resource.Operations, operationSummary{
+				Operation:     name,
+				Count:         v.count,
+				Errors:        v.errors,
+				TotalMillis:   v.total.Milliseconds(),
+				AverageMillis: (v.total / time.Duration(v.count)).Milliseconds(),
+				MaxMillis:     v.max.Milliseconds(),
+				APICalls:      v.apiCalls,
+				Retries:       v.retries,
+			})
+		}
+		slices.SortFunc(resource.Operations, func(a, b operationSummary) int {
+			return cmp.Compare(a.Operation, b.Operation)
+		})
+
+		s.Resources = append(s.Resources, resource)
+	}
+	slices.SortFunc(s.Resources, func(a, b resourceSummary) int {
+		return cmp.Or(cmp.Compare(a.TypeName, b.TypeName), cmp.Compare(a.Kind, b.Kind))
+	})
+
+	return s
+}
+
+func apiCallSummaries(m map[apiCallKey]*apiCallStats) []apiCallSummary {
+	s := make([]apiCallSummary, 0, len(m))
+
+	for k, v := range m {
+		s = append(s, apiCallSummary{
+			Service:   k.service,
+			Operation: k.operation,
+			Count:     v.count,
+			Retries:   v.retries,
+			Errors:    v.errors,
+		})
+	}
+	slices.SortFunc(s, func(a, b apiCallSummary) int {
+		return cmp.Or(cmp.Compare(a.Service, b.Service), cmp.Compare(a.Operation, b.Operation))
+	})
+
+	return s
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package telemetry
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	r := newRecorder(clock.Now)
+
+	if !r.IsEmpty() {
+		t.Fatal("expected empty recorder")
+	}
+
+	// Provider configuration makes calls outside of any operation.
+	r.RecordAPICall(nil, "STS", "GetCallerIdentity", 1, false)
+
+	read1, read2, create := new(int), new(int), new(int)
+
+	r.StartOperation(read1, KindResource, "aws_instance", "Read")
+	r.StartOperation(read2, KindResource, "aws_instance", "Read")
+	r.RecordAPICall(read1, "EC2", "DescribeInstances", 1, false)
+	r.RecordAPICall(read2, "EC2", "DescribeInstances", 3, false)
+	clock.Advance(100 * time.Millisecond)
+	r.FinishOperation(read1, false)
+	clock.Advance(200 * time.Millisecond)
+	r.FinishOperation(read2, false)
+
+	r.StartOperation(create, KindResource, "aws_instance", "Create")
+	r.RecordAPICall(create, "EC2", "RunInstances", 4, true)
+	clock.Advance(time.Second)
+	r.FinishOperation(create, true)
+
+	// Calls made after an operation has finished are not attributed to it.
+	r.RecordAPICall(create, "EC2", "DescribeInstances", 1, false)
+
+	// Unknown operations are ignored.
+	r.FinishOperation(new(int), false)
+
+	got := r.summary()
+
+	want := summary{
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 1, 0, 0, 1, 300_000_000, time.UTC),
+		Resources: []resourceSummary{
+			{
+				Kind:     KindResource,
+				TypeName: "aws_instance",
+				Operations: []operationSummary{
+					{Operation: "Create", Count: 1, Errors: 1, TotalMillis: 1000, AverageMillis: 1000, MaxMillis: 1000, APICalls: 1, Retries: 3},
+					{Operation: "Read", Count: 2, TotalMillis: 400, AverageMillis: 200, MaxMillis: 300, APICalls: 2, Retries: 2},
+				},
+				APICalls: []apiCallSummary{
+					{Service: "EC2", Operation: "DescribeInstances", Count: 2, Retries: 2},
+					{Service: "EC2", Operation: "RunInstances", Count: 1, Retries: 3, Errors: 1},
+				},
+			},
+		},
+		UnattributedAPICalls: []apiCallSummary{
+			{Service: "EC2", Operation: "DescribeInstances", Count: 1},
+			{Service: "STS", Operation: "GetCallerIdentity", Count: 1},
+		},
+	}
+
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(summary{}, "ProviderVersion", "PID")); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRecorder_AppendSummary(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "telemetry.jsonl")
+
+	r := NewRecorder()
+	if err := r.AppendSummary(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected no file to be written for an empty recorder, got: %v", err)
+	}
+
+	key := new(int)
+	r.StartOperation(key, KindDataSource, "aws_caller_identity", "Read")
+	r.RecordAPICall(key, "STS", "GetCallerIdentity", 1, false)
+	r.FinishOperation(key, false)
+
+	for range 2 {
+		if err := r.AppendSummary(path); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer f.Close()
+
+	var lines int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines++
+
+		var s summary
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			t.Fatalf("decoding line %d: %s", lines, err)
+		}
+		if got, want := len(s.Resources), 1; got != want {
+			t.Errorf("line %d: resources: got %d, want %d", lines, got, want)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := lines, 2; got != want {
+		t.Errorf("lines: got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package telemetry
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+var defaultRecorder = NewRecorder()
+
+// StartOperation records the start of an operation using the provider-wide Recorder.
+func StartOperation(key any, kind, typeName, operation string) {
+	defaultRecorder.StartOperation(key, kind, typeName, operation)
+}
+
+// FinishOperation records the end of an operation using the provider-wide Recorder.
+func FinishOperation(key any, failed bool) {
+	defaultRecorder.FinishOperation(key, failed)
+}
+
+// WriteSummary appends the provider-wide Recorder's summary to the file named by TF_AWS_TELEMETRY_FILE.
+// It is called once, when the provider shuts down.
+func WriteSummary() error {
+	if !IsEnabled() {
+		return nil
+	}
+
+	return defaultRecorder.AppendSummary(Path())
+}
+
+// APIOptions returns AWS SDK for Go v2 API client options that record each API call using the provider-wide Recorder.
+// keyFunc returns the key identifying any in-flight operation that the call is made from.
+func APIOptions(keyFunc func(context.Context) any) []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(recordAPICallMiddleware(defaultRecorder, keyFunc), middleware.After)
+		},
+	}
+}
+
+func recordAPICallMiddleware(r *Recorder, keyFunc func(context.Context) any) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(
+		"TelemetryRecordAPICall",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (out middleware.InitializeOutput, metadata middleware.Metadata, err error) {
+			out, metadata, err = next.HandleInitialize(ctx, in)
+
+			attempts := 1
+			if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+				attempts = len(v.Results)
+			}
+			r.RecordAPICall(keyFunc(ctx), awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), attempts, err != nil)
+
+			return out, metadata, err
+		},
+	)
+}
@@ -113,3 +113,9 @@ func (d Duration) equal(o Duration) bool {
 func Sub(t time.Time, d Duration) time.Time {
 	return t.AddDate(-d.years, -d.months, -d.days)
 }
+
+// Add returns the time t+d.
+// Years, months and days are added to the calendar date, so the result is not affected by daylight saving time.
+func Add(t time.Time, d Duration) time.Time {
+	return t.AddDate(d.years, d.months, d.days)
+}
@@ -213,3 +213,69 @@ func TestSub(t *testing.T) {
 		})
 	}
 }
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tz, err := time.LoadLocation("America/Vancouver")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := map[string]struct {
+		startTime time.Time
+		duration  Duration
+		expected  time.Time
+		hoursDiff int
+	}{
+		"zero": {
+			startTime: now,
+			duration:  Duration{},
+			expected:  now,
+		},
+		"regular": {
+			startTime: now,
+			duration:  Duration{years: 1, months: 2, days: 3},
+			expected:  now.AddDate(1, 2, 3),
+		},
+
+		"month": {
+			startTime: time.Date(2022, 3, 1, 0, 0, 0, 0, tz),
+			duration:  Duration{months: 1},
+			expected:  time.Date(2022, 4, 1, 0, 0, 0, 0, tz),
+		},
+
+		"day": {
+			startTime: time.Date(2022, 4, 11, 12, 0, 0, 0, tz),
+			duration:  Duration{days: 3},
+			expected:  time.Date(2022, 4, 14, 12, 0, 0, 0, tz),
+			hoursDiff: 3 * 24,
+		},
+		"daylight saving day": {
+			startTime: time.Date(2022, 3, 11, 12, 0, 0, 0, tz),
+			duration:  Duration{days: 3},
+			expected:  time.Date(2022, 3, 14, 12, 0, 0, 0, tz),
+			hoursDiff: 3*24 - 1,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := Add(tc.startTime, tc.duration)
+
+			if !actual.Equal(tc.expected) {
+				t.Fatalf("expected %s, got %s", tc.expected, actual)
+			}
+
+			if tc.hoursDiff != 0 {
+				diff := tc.expected.Sub(tc.startTime)
+				if diff.Hours() != float64(tc.hoursDiff) {
+					t.Fatalf("diff expected %d, got %f", tc.hoursDiff, diff.Hours())
+				}
+			}
+		})
+	}
+}
@@ -6,13 +6,15 @@ package vcr
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
 const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
+	envVarVCRMode   = "VCR_MODE"
+	envVarVCRPath   = "VCR_PATH"
+	envVarVCRRedact = "VCR_REDACT"
 
 	vcrModeRecordOnly = "RECORD_ONLY"
 	vcrModeReplayOnly = "REPLAY_ONLY"
@@ -28,13 +30,26 @@ func IsEnabled() bool {
 
 // Mode returns the VCR recording mode inferred from the VCR_MODE environment variable
 func Mode() (recorder.Mode, error) {
-	switch v := os.Getenv(envVarVCRMode); v {
+	v := os.Getenv(envVarVCRMode)
+	mode, err := ParseMode(v)
+	if err != nil {
+		return mode, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
+	}
+
+	return mode, nil
+}
+
+// ParseMode returns the VCR recording mode for the specified value
+//
+// Valid values are RECORD_ONLY and REPLAY_ONLY.
+func ParseMode(v string) (recorder.Mode, error) {
+	switch v {
 	case vcrModeRecordOnly:
 		return recorder.ModeRecordOnly, nil
 	case vcrModeReplayOnly:
 		return recorder.ModeReplayOnly, nil
 	default:
-		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
+		return recorder.ModePassthrough, fmt.Errorf("unsupported VCR mode: %s", v)
 	}
 }
 
@@ -42,3 +57,11 @@ func Mode() (recorder.Mode, error) {
 func Path() string {
 	return os.Getenv(envVarVCRPath)
 }
+
+// IsRedactionEnabled indicates whether recorded interactions should be redacted
+//
+// Returns true if the VCR_REDACT environment variable is set to a true value.
+func IsRedactionEnabled() bool {
+	v, _ := strconv.ParseBool(os.Getenv(envVarVCRRedact))
+	return v
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+)
+
+// RequestMatcher customizes how requests to a service are matched to recorded interactions
+type RequestMatcher struct {
+	// IgnoreFields lists request body fields that are ignored when matching, e.g. idempotency tokens or timestamps.
+	// Fields are compared with JSON object keys at any depth and with the components of query protocol
+	// form parameter names, so that "ClientToken" also ignores "LaunchSpecification.ClientToken".
+	IgnoreFields []string
+
+	// IgnoreQueryParameters lists URL query parameters that are ignored when matching.
+	IgnoreQueryParameters []string
+}
+
+func (m RequestMatcher) merge(other RequestMatcher) RequestMatcher {
+	return RequestMatcher{
+		IgnoreFields:          append(slices.Clone(m.IgnoreFields), other.IgnoreFields...),
+		IgnoreQueryParameters: append(slices.Clone(m.IgnoreQueryParameters), other.IgnoreQueryParameters...),
+	}
+}
+
+// isIgnoredField returns whether the specified JSON object key or form parameter name is ignored.
+func (m RequestMatcher) isIgnoredField(name string) bool {
+	for part := range strings.SplitSeq(name, ".") {
+		if slices.Contains(m.IgnoreFields, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeIgnoredFields removes ignored fields from a decoded JSON value.
+func (m RequestMatcher) removeIgnoredFields(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if m.isIgnoredField(k) {
+				delete(v, k)
+				continue
+			}
+			m.removeIgnoredFields(e)
+		}
+	case []any:
+		for _, e := range v {
+			m.removeIgnoredFields(e)
+		}
+	}
+}
+
+// removeIgnoredFormFields removes ignored fields from decoded form values.
+func (m RequestMatcher) removeIgnoredFormFields(v url.Values) {
+	for k := range v {
+		if m.isIgnoredField(k) {
+			v.Del(k)
+		}
+	}
+}
+
+// matchURL returns whether the request URL matches the recorded URL, disregarding ignored query parameters.
+func (m RequestMatcher) matchURL(requestURL, cassetteURL string) bool {
+	if requestURL == cassetteURL {
+		return true
+	}
+
+	if len(m.IgnoreQueryParameters) == 0 {
+		return false
+	}
+
+	r, err := url.Parse(requestURL)
+	if err != nil {
+		return false
+	}
+	c, err := url.Parse(cassetteURL)
+	if err != nil {
+		return false
+	}
+
+	rq, cq := r.Query(), c.Query()
+	for _, v := range m.IgnoreQueryParameters {
+		rq.Del(v)
+		cq.Del(v)
+	}
+	r.RawQuery, c.RawQuery = rq.Encode(), cq.Encode()
+
+	return r.String() == c.String()
+}
+
+var (
+	// defaultRequestMatcher applies to requests to all services.
+	defaultRequestMatcher = RequestMatcher{
+		// Idempotency tokens are generated by the AWS SDK for Go v2 for each request.
+		IgnoreFields: []string{
+			"ClientRequestToken",
+			"ClientToken",
+			"IdempotencyToken",
+		},
+	}
+
+	requestMatchers = struct {
+		sync.RWMutex
+		matchers map[string]RequestMatcher
+	}{
+		matchers: map[string]RequestMatcher{
+			// CloudWatch Logs queries are relative to the current time.
+			"logs": {
+				IgnoreFields: []string{"endTime", "startTime"},
+			},
+			// CloudWatch metric queries are relative to the current time.
+			"monitoring": {
+				IgnoreFields: []string{"EndTime", "StartTime"},
+			},
+		},
+	}
+)
+
+// RegisterRequestMatcher registers a RequestMatcher for requests to the service with the specified
+// SigV4 signing name, e.g. "ec2" or "monitoring"
+//
+// Matchers registered for the same service are merged, and all matchers extend the default matcher,
+// which ignores idempotency tokens.
+func RegisterRequestMatcher(signingName string, m RequestMatcher) {
+	requestMatchers.Lock()
+	defer requestMatchers.Unlock()
+
+	if v, ok := requestMatchers.matchers[signingName]; ok {
+		m = v.merge(m)
+	}
+	requestMatchers.matchers[signingName] = m
+}
+
+// requestMatcherFor returns the RequestMatcher for the service the request is sent to.
+func requestMatcherFor(r *http.Request) RequestMatcher {
+	requestMatchers.RLock()
+	defer requestMatchers.RUnlock()
+
+	if v, ok := requestMatchers.matchers[signingName(r)]; ok {
+		return defaultRequestMatcher.merge(v)
+	}
+
+	return defaultRequestMatcher
+}
+
+// signingName returns the SigV4 signing name from a signed request's credential scope.
+func signingName(r *http.Request) string {
+	// Authorization: AWS4-HMAC-SHA256 Credential=<access key>/<date>/<region>/<service>/aws4_request, ...
+	if m := regexache.MustCompile(`Credential=[^/]+/[^/]+/[^/]+/([^/]+)/aws4_request`).FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		return m[1]
+	}
+
+	return ""
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// DefaultCassetteName is the name of the cassette used for provider-wide recording
+// when no cassette name is configured.
+const DefaultCassetteName = "terraform-provider-aws"
+
+// Config configures provider-wide recording and replay of AWS API calls.
+type Config struct {
+	CassetteName string
+	Mode         recorder.Mode
+	Path         string
+	Redact       bool
+}
+
+// CassettePath returns the path of the cassette file, without extension.
+func (c *Config) CassettePath() string {
+	name := c.CassetteName
+	if name == "" {
+		name = DefaultCassetteName
+	}
+
+	return filepath.Join(c.Path, name)
+}
+
+// IsReplaying indicates whether API calls are replayed from the cassette rather than sent to AWS.
+func (c *Config) IsReplaying() bool {
+	return c.Mode == recorder.ModeReplayOnly
+}
+
+// environmentProviderRecordingEnabled indicates whether VCR_MODE and VCR_PATH configure provider-wide recording.
+// Acceptance tests use the same environment variables to manage a recorder per test, so provider-wide
+// recording is only configured from the environment when the provider is being served to Terraform.
+var environmentProviderRecordingEnabled atomic.Bool
+
+// EnableProviderRecordingFromEnvironment allows VCR_MODE and VCR_PATH to configure provider-wide recording.
+// It is called once, before the provider is served.
+func EnableProviderRecordingFromEnvironment() {
+	environmentProviderRecordingEnabled.Store(true)
+}
+
+// ConfigFromEnvironment returns any provider-wide recording configuration set by environment variables.
+// It returns nil if provider-wide recording is not configured.
+func ConfigFromEnvironment() (*Config, error) {
+	if !environmentProviderRecordingEnabled.Load() || !IsEnabled() {
+		return nil, nil
+	}
+
+	mode, err := Mode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		Mode:   mode,
+		Path:   Path(),
+		Redact: IsRedactionEnabled(),
+	}, nil
+}
+
+var providerRecorders = struct {
+	sync.Mutex
+	clients map[string]*http.Client
+}{
+	clients: make(map[string]*http.Client),
+}
+
+// ProviderHTTPClient returns the HTTP client recording to, or replaying from, the configured cassette.
+// Provider configurations sharing a cassette share an HTTP client, as VCR requires a single
+// recorder to handle all of a cassette's interactions.
+func ProviderHTTPClient(ctx context.Context, config *Config) (*http.Client, error) {
+	providerRecorders.Lock()
+	defer providerRecorders.Unlock()
+
+	cassetteName := config.CassettePath()
+
+	if v, ok := providerRecorders.clients[cassetteName]; ok {
+		return v, nil
+	}
+
+	httpClient, err := NewHTTPClient(ctx, cassetteName, config.Mode, config.Redact)
+	if err != nil {
+		return nil, err
+	}
+
+	providerRecorders.clients[cassetteName] = httpClient
+
+	return httpClient, nil
+}
+
+// StopProviderRecorders stops all provider-wide recorders, saving any recorded cassettes.
+// It is called once, when the provider shuts down.
+func StopProviderRecorders() error {
+	providerRecorders.Lock()
+	defer providerRecorders.Unlock()
+
+	var errs []error
+
+	for cassetteName, httpClient := range providerRecorders.clients {
+		if v, ok := httpClient.Transport.(*recorder.Recorder); ok {
+			if err := v.Stop(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		delete(providerRecorders.clients, cassetteName)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestConfigFromEnvironment(t *testing.T) {
+	t.Setenv(envVarVCRMode, vcrModeReplayOnly)
+	t.Setenv(envVarVCRPath, "cassettes")
+
+	// Acceptance tests set the same environment variables.
+	got, err := ConfigFromEnvironment()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != nil {
+		t.Fatalf("expected no configuration before provider recording is enabled, got: %v", got)
+	}
+
+	EnableProviderRecordingFromEnvironment()
+
+	got, err = ConfigFromEnvironment()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Config{
+		Mode: recorder.ModeReplayOnly,
+		Path: "cassettes",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, want := got.CassettePath(), filepath.Join("cassettes", DefaultCassetteName); got != want {
+		t.Errorf("CassettePath: got %s, want %s", got, want)
+	}
+
+	t.Setenv(envVarVCRRedact, "true")
+
+	got, err = ConfigFromEnvironment()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !got.Redact {
+		t.Error("expected redaction to be enabled")
+	}
+
+	t.Setenv(envVarVCRMode, "RECORD_ONCE")
+
+	if _, err := ConfigFromEnvironment(); err == nil {
+		t.Error("expected error for unsupported mode")
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// NewHTTPClient returns an HTTP client whose transport is a VCR recorder
+// saving interactions to, or replaying interactions from, the named cassette
+//
+// If redactionEnabled is true, registered redactions are applied to interactions before they are saved,
+// and to requests before they are matched to recorded interactions.
+func NewHTTPClient(ctx context.Context, cassetteName string, mode recorder.Mode, redactionEnabled bool) (*http.Client, error) {
+	// Real transport config, cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	if tlsConfig := transport.TLSClientConfig; tlsConfig == nil {
+		tlsConfig = &tls.Config{
+			MinVersion: tls.VersionTLS13,
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	opts := []recorder.Option{
+		recorder.WithHook(sensitiveHeaderHook, recorder.AfterCaptureHook),
+		recorder.WithMatcher(matcher(ctx, redactionEnabled)),
+		recorder.WithMode(mode),
+		recorder.WithRealTransport(httpClient.Transport),
+		recorder.WithSkipRequestLatency(true),
+	}
+	if redactionEnabled {
+		opts = append(opts, recorder.WithHook(redactHook, recorder.BeforeSaveHook))
+	}
+
+	// Create a VCR recorder around a default HTTP client.
+	r, err := recorder.New(cassetteName, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient.Transport = r
+
+	return httpClient, nil
+}
+
+// sensitiveHeaderHook is an after capture hook to remove sensitive HTTP headers.
+func sensitiveHeaderHook(i *cassette.Interaction) error {
+	delete(i.Request.Headers, "Authorization")
+	delete(i.Request.Headers, "X-Amz-Security-Token")
+	return nil
+}
+
+// matcher defines how VCR will match requests to stored interactions.
+//
+// If redactionEnabled is true, requests are redacted in the same way as recorded interactions before they are compared.
+// Any fields ignored by the RequestMatcher registered for the request's service are disregarded.
+func matcher(ctx context.Context, redactionEnabled bool) recorder.MatcherFunc {
+	redactFunc := func(s string) string {
+		if redactionEnabled {
+			return redact(s)
+		}
+		return s
+	}
+
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		m := requestMatcherFor(r)
+
+		if !m.matchURL(redactFunc(r.URL.String()), i.URL) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := redactFunc(b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		switch contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson any
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			m.removeIgnoredFields(requestJson)
+			m.removeIgnoredFields(cassetteJson)
+
+			return reflect.DeepEqual(requestJson, cassetteJson)
+
+		case "application/x-www-form-urlencoded":
+			// Query protocol parameters might be the same, but reordered. Try parsing and comparing.
+			requestForm, err := url.ParseQuery(body)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse request form", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			cassetteForm, err := url.ParseQuery(i.Body)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse cassette form", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			m.removeIgnoredFormFields(requestForm)
+			m.removeIgnoredFormFields(cassetteForm)
+
+			return reflect.DeepEqual(requestForm, cassetteForm)
+
+		case "application/xml":
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml any
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
+		}
+
+		return false
+	}
+}
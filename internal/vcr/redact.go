@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+const (
+	// RedactedAccountID replaces AWS account IDs in recorded interactions.
+	RedactedAccountID = "123456789012"
+	// RedactedValue replaces secret values in recorded interactions.
+	RedactedValue = "REDACTED"
+)
+
+// Redaction replaces all matches of a regular expression in recorded interactions
+//
+// Replacement may refer to capture groups, as in regexp.Regexp.ReplaceAllString.
+// The same redactions are applied to requests before they are matched to recorded
+// interactions, so a redacted value in a request does not prevent replay.
+type Redaction struct {
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+func (r Redaction) apply(s string) string {
+	return r.Pattern.ReplaceAllString(s, r.Replacement)
+}
+
+// secretFieldNames lists the request and response fields whose values are redacted.
+var secretFieldNames = []string{
+	"AuthToken",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Password",
+	"PrivateKey",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+}
+
+var (
+	// AccountIDRedactions replace AWS account IDs in ARNs, SQS queue URLs and account ID fields.
+	AccountIDRedactions = []Redaction{
+		{
+			Pattern:     regexache.MustCompile(`(arn(?::|%3[Aa])aws[\w-]*(?::|%3[Aa])[\w-]*(?::|%3[Aa])[\w-]*(?::|%3[Aa]))\d{12}\b`),
+			Replacement: "${1}" + RedactedAccountID,
+		},
+		{
+			Pattern:     regexache.MustCompile(`(\.amazonaws\.com(?:\.cn)?(?:/|%2F))\d{12}\b`),
+			Replacement: "${1}" + RedactedAccountID,
+		},
+		{
+			Pattern:     regexache.MustCompile(`((?:"|<|\b)(?i:account|accountId|ownerId)(?:"\s*:\s*"|>|=))\d{12}\b`),
+			Replacement: "${1}" + RedactedAccountID,
+		},
+	}
+
+	// SecretRedactions replace the values of well-known secret fields in JSON, XML and query protocol bodies.
+	SecretRedactions = []Redaction{
+		{
+			Pattern:     regexache.MustCompile(`("(?:` + strings.Join(secretFieldNames, "|") + `)"\s*:\s*")(?:[^"\\]|\\.)*(")`),
+			Replacement: "${1}" + RedactedValue + "${2}",
+		},
+		{
+			Pattern:     regexache.MustCompile(`(<(` + strings.Join(secretFieldNames, "|") + `)>)[^<]*(</)`),
+			Replacement: "${1}" + RedactedValue + "${3}",
+		},
+		{
+			Pattern:     regexache.MustCompile(`((?:^|[?&.])(?:` + strings.Join(secretFieldNames, "|") + `)=)[^&]*`),
+			Replacement: "${1}" + RedactedValue,
+		},
+	}
+
+	redactions = struct {
+		sync.RWMutex
+		redactions []Redaction
+	}{
+		redactions: slices.Concat(AccountIDRedactions, SecretRedactions),
+	}
+)
+
+// RegisterRedaction adds a Redaction that is applied to all recorded interactions
+func RegisterRedaction(r Redaction) {
+	redactions.Lock()
+	defer redactions.Unlock()
+
+	redactions.redactions = append(redactions.redactions, r)
+}
+
+// SetRedactions replaces all registered redactions, including the default account ID and secret redactions
+//
+// Calling SetRedactions with no arguments disables redaction.
+func SetRedactions(r ...Redaction) {
+	redactions.Lock()
+	defer redactions.Unlock()
+
+	redactions.redactions = slices.Clone(r)
+}
+
+// redact applies all registered redactions to a string.
+func redact(s string) string {
+	redactions.RLock()
+	defer redactions.RUnlock()
+
+	for _, r := range redactions.redactions {
+		s = r.apply(s)
+	}
+
+	return s
+}
+
+// redactValues applies all registered redactions to HTTP header or form values.
+func redactValues(m map[string][]string) {
+	for k, v := range m {
+		for i, s := range v {
+			v[i] = redact(s)
+		}
+		m[k] = v
+	}
+}
+
+// redactForm applies all registered redactions to form values, and redacts the values of secret fields.
+func redactForm(form map[string][]string) {
+	redactValues(form)
+
+	for k, v := range form {
+		if name := k[strings.LastIndex(k, ".")+1:]; slices.Contains(secretFieldNames, name) {
+			for i := range v {
+				v[i] = RedactedValue
+			}
+		}
+	}
+}
+
+// redactHook is a before save hook to redact account IDs and secret values from recorded interactions.
+func redactHook(i *cassette.Interaction) error {
+	i.Request.URL = redact(i.Request.URL)
+	i.Request.RequestURI = redact(i.Request.RequestURI)
+	redactValues(i.Request.Headers)
+	redactForm(i.Request.Form)
+	if body := redact(i.Request.Body); body != i.Request.Body {
+		i.Request.Body = body
+		i.Request.ContentLength = int64(len(body))
+	}
+
+	redactValues(i.Response.Headers)
+	if body := redact(i.Response.Body); body != i.Response.Body {
+		i.Response.Body = body
+		// Keep the recorded response consistent with its redacted body.
+		// Checksums of the original body would fail AWS SDK response validation.
+		if i.Response.ContentLength >= 0 {
+			i.Response.ContentLength = int64(len(body))
+		}
+		for k := range i.Response.Headers {
+			switch v := strings.ToLower(k); {
+			case v == "content-length":
+				i.Response.Headers[k] = []string{strconv.Itoa(len(body))}
+			case v == "content-md5", v == "x-amz-crc32", strings.HasPrefix(v, "x-amz-checksum-"):
+				delete(i.Response.Headers, k)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"no match": {
+			input:    `{"Name":"test"}`,
+			expected: `{"Name":"test"}`,
+		},
+		"ARN": {
+			input:    `{"RoleArn":"arn:aws:iam::111122223333:role/test"}`,
+			expected: `{"RoleArn":"arn:aws:iam::123456789012:role/test"}`,
+		},
+		"ARN other partition": {
+			input:    `<Arn>arn:aws-us-gov:sqs:us-gov-west-1:111122223333:test</Arn>`, //lintignore:AWSAT003,AWSAT005
+			expected: `<Arn>arn:aws-us-gov:sqs:us-gov-west-1:123456789012:test</Arn>`, //lintignore:AWSAT003,AWSAT005
+		},
+		"URL encoded ARN": {
+			input:    `Action=GetRole&PolicyArn=arn%3Aaws%3Aiam%3A%3A111122223333%3Apolicy%2Ftest`,
+			expected: `Action=GetRole&PolicyArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Apolicy%2Ftest`,
+		},
+		"queue URL": {
+			input:    `QueueUrl=https%3A%2F%2Fsqs.us-west-2.amazonaws.com%2F111122223333%2Ftest`, //lintignore:AWSAT003
+			expected: `QueueUrl=https%3A%2F%2Fsqs.us-west-2.amazonaws.com%2F123456789012%2Ftest`, //lintignore:AWSAT003
+		},
+		"JSON account ID": {
+			input:    `{"AccountId": "111122223333", "OwnerId":"111122223333"}`,
+			expected: `{"AccountId": "123456789012", "OwnerId":"123456789012"}`,
+		},
+		"XML account ID": {
+			input:    `<GetCallerIdentityResult><Account>111122223333</Account></GetCallerIdentityResult>`,
+			expected: `<GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult>`,
+		},
+		"not an account ID": {
+			input:    `{"Size":1111222233334444}`,
+			expected: `{"Size":1111222233334444}`,
+		},
+		"JSON secret": {
+			input:    `{"SecretId":"test","SecretString":"s3cr3t \"quoted\""}`,
+			expected: `{"SecretId":"test","SecretString":"REDACTED"}`,
+		},
+		"XML secret": {
+			input:    `<Credentials><AccessKeyId>ASIATEST</AccessKeyId><SecretAccessKey>s3cr3t</SecretAccessKey><SessionToken>t0k3n</SessionToken></Credentials>`,
+			expected: `<Credentials><AccessKeyId>ASIATEST</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>`,
+		},
+		"form secret": {
+			input:    `Action=CreateCacheCluster&AuthToken=s3cr3t&CacheClusterId=test`,
+			expected: `Action=CreateCacheCluster&AuthToken=REDACTED&CacheClusterId=test`,
+		},
+		"form secret prefix": {
+			input:    `Action=ModifyDBCluster&MasterUserPassword=s3cr3t&DBClusterIdentifier=test`,
+			expected: `Action=ModifyDBCluster&MasterUserPassword=REDACTED&DBClusterIdentifier=test`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := redact(testCase.input), testCase.expected; got != want {
+				t.Errorf("redact(%q) = %q, want %q", testCase.input, got, want)
+			}
+		})
+	}
+}
+
+func TestRedactHook(t *testing.T) {
+	t.Parallel()
+
+	body := `{"SecretString":"s3cr3t","ARN":"arn:aws:secretsmanager:us-west-2:111122223333:secret:test"}` //lintignore:AWSAT003,AWSAT005
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body: `{"SecretId":"arn:aws:secretsmanager:us-west-2:111122223333:secret:test"}`, //lintignore:AWSAT003,AWSAT005
+			Form: map[string][]string{
+				"Password": {"s3cr3t"},
+			},
+			URL: "https://secretsmanager.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+		},
+		Response: cassette.Response{
+			Body:          body,
+			ContentLength: int64(len(body)),
+			Headers: http.Header{
+				"Content-Length": {strconv.Itoa(len(body))},
+				"X-Amz-Crc32":    {"1234567890"},
+			},
+		},
+	}
+
+	if err := redactHook(i); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := i.Request.Body, `{"SecretId":"arn:aws:secretsmanager:us-west-2:123456789012:secret:test"}`; got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("Request.Body = %q, want %q", got, want)
+	}
+	if got, want := i.Request.Form.Get("Password"), RedactedValue; got != want {
+		t.Errorf("Request.Form[Password] = %q, want %q", got, want)
+	}
+	if got, want := i.Response.Body, `{"SecretString":"REDACTED","ARN":"arn:aws:secretsmanager:us-west-2:123456789012:secret:test"}`; got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("Response.Body = %q, want %q", got, want)
+	}
+	if got, want := i.Response.ContentLength, int64(len(i.Response.Body)); got != want {
+		t.Errorf("Response.ContentLength = %d, want %d", got, want)
+	}
+	if got, want := i.Response.Headers.Get("Content-Length"), strconv.Itoa(len(i.Response.Body)); got != want {
+		t.Errorf("Response.Headers[Content-Length] = %q, want %q", got, want)
+	}
+	if _, ok := i.Response.Headers["X-Amz-Crc32"]; ok {
+		t.Error("expected X-Amz-Crc32 header to be removed")
+	}
+}
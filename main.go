@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/telemetry"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
@@ -32,6 +34,9 @@ func main() {
 		log.Fatal(err)
 	}
 
+	// Allow VCR_MODE and VCR_PATH to record or replay the AWS API calls made by the provider.
+	vcr.EnableProviderRecordingFromEnvironment()
+
 	var serveOpts []tf5server.ServeOpt
 
 	if *debugFlag {
@@ -44,6 +49,14 @@ func main() {
 		serveOpts...,
 	)
 
+	// Serve returns when Terraform shuts the provider down.
+	if err := telemetry.WriteSummary(); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+	if err := vcr.StopProviderRecorders(); err != nil {
+		log.Printf("[WARN] saving VCR cassette: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
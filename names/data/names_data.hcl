@@ -8218,7 +8218,6 @@ service "sso" {
   provider_package_correct = "sso"
   doc_prefix               = ["sso_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "ssoadmin" {